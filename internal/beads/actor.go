@@ -0,0 +1,43 @@
+// Package beads provides resolution of the actor recorded for changes.
+package beads
+
+import (
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// UnknownActor is the last-resort actor when no identity can be determined.
+const UnknownActor = "unknown"
+
+// ResolveActor returns the identity to record for events, audit entries,
+// created beads and status transitions. Sources are tried in order: the
+// explicit override, the GT_ACTOR and BD_ACTOR environment variables, then
+// each fallback in turn (callers that can detect the Gas Town role pass
+// that first; see GitActor). UnknownActor is returned only when all of them
+// are empty.
+func ResolveActor(override string, fallbacks ...func() string) string {
+	if actor := strings.TrimSpace(override); actor != "" {
+		return actor
+	}
+	for _, env := range []string{"GT_ACTOR", "BD_ACTOR"} {
+		if actor := strings.TrimSpace(os.Getenv(env)); actor != "" {
+			return actor
+		}
+	}
+	for _, fallback := range fallbacks {
+		if actor := fallback(); actor != "" {
+			return actor
+		}
+	}
+	return UnknownActor
+}
+
+// GitActor returns git's user.email, or "" if it is not configured.
+func GitActor() string {
+	out, err := exec.Command("git", "config", "user.email").Output()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(out))
+}
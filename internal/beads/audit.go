@@ -73,9 +73,10 @@ func (b *Beads) DetachMoleculeWithAudit(pinnedBeadID string, opts DetachOptions)
 }
 
 // LogDetachAudit appends an audit entry to the audit log file.
-// The audit log is stored in .beads/audit.log as JSONL format.
+// The audit log is stored in .beads/audit.log as JSONL format, in the
+// redirect target when workDir is a worktree.
 func (b *Beads) LogDetachAudit(entry DetachAuditEntry) error {
-	auditPath := filepath.Join(b.workDir, ".beads", "audit.log")
+	auditPath := filepath.Join(b.resolvedBeadsDir(), "audit.log")
 
 	// Marshal entry to JSON
 	data, err := json.Marshal(entry)
@@ -0,0 +1,69 @@
+package beads
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// bdStubPrelude logs each invocation to $BD_LOG and strips the global flags
+// Beads.run prepends, so stub bodies can switch directly on the subcommand.
+const bdStubPrelude = `#!/bin/sh
+echo "$*" >> "${BD_LOG}"
+while [ $# -gt 0 ]; do
+  case "$1" in
+    --allow-stale|--no-daemon) shift ;;
+    --db) shift; shift ;;
+    *) break ;;
+  esac
+done
+cmd="$1"
+shift || true
+`
+
+// installBDStub puts a fake bd on PATH whose behavior is given by body,
+// a shell fragment run after bdStubPrelude ($cmd holds the subcommand and
+// "$@" the remaining args). Returns a function reading the logged calls.
+func installBDStub(t *testing.T, body string) func() []string {
+	t.Helper()
+
+	dir := t.TempDir()
+	binDir := filepath.Join(dir, "bin")
+	if err := os.MkdirAll(binDir, 0755); err != nil {
+		t.Fatalf("mkdir bin: %v", err)
+	}
+	logPath := filepath.Join(dir, "bd.log")
+	script := bdStubPrelude + body + "\nexit 0\n"
+	if err := os.WriteFile(filepath.Join(binDir, "bd"), []byte(script), 0755); err != nil {
+		t.Fatalf("write bd stub: %v", err)
+	}
+
+	t.Setenv("BD_LOG", logPath)
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	return func() []string {
+		data, err := os.ReadFile(logPath)
+		if err != nil {
+			return nil
+		}
+		return strings.Split(strings.TrimSpace(string(data)), "\n")
+	}
+}
+
+// hasCall reports whether any logged bd call contains all the given substrings.
+func hasCall(calls []string, parts ...string) bool {
+	for _, call := range calls {
+		matched := true
+		for _, p := range parts {
+			if !strings.Contains(call, p) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+	return false
+}
@@ -3,13 +3,16 @@ package beads
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/steveyegge/gastown/internal/runtime"
 )
@@ -27,6 +30,7 @@ type Issue struct {
 	ID          string   `json:"id"`
 	Title       string   `json:"title"`
 	Description string   `json:"description"`
+	Acceptance  string   `json:"acceptance_criteria,omitempty"`
 	Status      string   `json:"status"`
 	Priority    int      `json:"priority"`
 	Type        string   `json:"issue_type"`
@@ -41,6 +45,9 @@ type Issue struct {
 	Blocks      []string `json:"blocks,omitempty"`
 	BlockedBy   []string `json:"blocked_by,omitempty"`
 	Labels      []string `json:"labels,omitempty"`
+	Ephemeral   bool     `json:"ephemeral,omitempty"`
+	Estimate    int      `json:"estimated_minutes,omitempty"` // Effort estimate in minutes; 0 if unestimated
+	Rig         string   `json:"rig,omitempty"`               // Set by ListAllRigs: rig listed from ("town" for town beads)
 
 	// Agent bead slots (type=agent only)
 	HookBead   string `json:"hook_bead,omitempty"`   // Current work attached to agent's hook
@@ -55,6 +62,11 @@ type Issue struct {
 	// Detailed dependency info from show output
 	Dependencies []IssueDep `json:"dependencies,omitempty"`
 	Dependents   []IssueDep `json:"dependents,omitempty"`
+
+	// TracksParents lists the epics and convoys this issue rolls up to
+	// through non-blocking tracks edges, alongside its single Parent. Set by
+	// Show from Dependents.
+	TracksParents []string `json:"tracks_parents,omitempty"`
 }
 
 // IssueDep represents a dependency or dependent issue with its relation.
@@ -76,8 +88,32 @@ type ListOptions struct {
 	Parent     string // filter by parent ID
 	Assignee   string // filter by assignee (e.g., "gastown/Toast")
 	NoAssignee bool   // filter for issues with no assignee
+	CreatedBy  string // filter by creator (e.g., "gastown/polecats/Toast"); applied client-side
+	SortBy     string // SortByCreatedAt, SortByUpdatedAt, SortByPriority, SortByID; empty keeps bd order
+	SortDesc   bool   // Reverse SortBy order
+	Limit      int    // Max issues returned, after any client-side filter and SortBy; 0 means up to ListCap
+	NoCap      bool   // With Limit 0, list everything instead of stopping at ListCap
+
+	// Dependency filters, applied client-side from bd's list counts.
+	HasDependencies *bool // true: only issues depending on others; false: only leaves
+	HasDependents   *bool // true: only issues others depend on; false: only issues nothing depends on
+	MinBlockedBy    int   // only issues with at least this many blockers; 0 for no filter
 }
 
+// ListCap bounds List when no explicit Limit is given, so a runaway or
+// corrupted database cannot exhaust memory in long-running loops. Results
+// past the cap are dropped with a warning on stderr.
+var ListCap = 10000
+
+// List sort keys for ListOptions.SortBy.
+// Priority sorts numerically, so ascending puts P0 first.
+const (
+	SortByCreatedAt = "created_at"
+	SortByUpdatedAt = "updated_at"
+	SortByPriority  = "priority"
+	SortByID        = "id"
+)
+
 // CreateOptions specifies options for creating an issue.
 type CreateOptions struct {
 	Title       string
@@ -85,8 +121,31 @@ type CreateOptions struct {
 	Priority    int    // 0-4
 	Description string
 	Parent      string
+	Assignee    string // Create already assigned (e.g., "gastown/polecats/Toast")
 	Actor       string // Who is creating this issue (populates created_by)
 	Ephemeral   bool   // Create as ephemeral (wisp) - not exported to JSONL
+	Estimate    int    // Effort estimate in minutes; 0 leaves it unestimated
+	DryRun      bool   // Only Validate the request; Create returns a nil issue
+
+	// Labels are added to the issue alongside the gt:<type> and TTL labels.
+	// Whitespace is trimmed and duplicates dropped.
+	Labels []string
+
+	// TTL marks the issue for expiry by ExpireEphemeral once it is older than
+	// the given duration. Zero means the issue never expires.
+	TTL time.Duration
+
+	// DependsOn lists issues the new issue is blocked by. DependsOnTyped adds
+	// dependencies of other types. Both are linked as part of Create; if any
+	// link fails the new issue is deleted.
+	DependsOn      []string
+	DependsOnTyped []TypedDep
+}
+
+// TypedDep is a dependency on another issue with an explicit type (see DepType*).
+type TypedDep struct {
+	ID   string
+	Type string
 }
 
 // UpdateOptions specifies options for updating an issue.
@@ -112,8 +171,9 @@ type SyncStatus struct {
 // Beads wraps bd CLI operations for a working directory.
 type Beads struct {
 	workDir  string
-	beadsDir string // Optional BEADS_DIR override for cross-database access
-	isolated bool   // If true, suppress inherited beads env vars (for test isolation)
+	beadsDir string         // Optional BEADS_DIR override for cross-database access
+	isolated bool           // If true, suppress inherited beads env vars (for test isolation)
+	labels   *LabelTaxonomy // Optional taxonomy Create and Update check labels against
 }
 
 // New creates a new Beads wrapper for the given directory.
@@ -144,26 +204,96 @@ func (b *Beads) getActor() string {
 	return os.Getenv("BD_ACTOR")
 }
 
+// InitOptions specifies options for initializing a beads database.
+type InitOptions struct {
+	Prefix string
+	// Config holds bd config keys (e.g., "types.custom") set as part of
+	// InitWithOptions, so callers never see a half-configured database.
+	Config map[string]string
+}
+
 // Init initializes a new beads database in the working directory.
 // This uses the same environment isolation as other commands.
 func (b *Beads) Init(prefix string) error {
-	_, err := b.run("init", "--prefix", prefix, "--quiet")
-	return err
+	return b.InitWithOptions(InitOptions{Prefix: prefix})
+}
+
+// InitWithOptions initializes a new beads database and applies opts.Config
+// immediately after, so callers never see a half-configured database. If
+// the database initializes but some config keys cannot be set, the error is
+// a *ConfigError.
+func (b *Beads) InitWithOptions(opts InitOptions) error {
+	if _, err := b.run("init", "--prefix", opts.Prefix, "--quiet"); err != nil {
+		return err
+	}
+	return b.ApplyConfig(opts.Config)
+}
+
+// ConfigError reports the config keys ApplyConfig could not set. Older bd
+// versions reject some keys (e.g., types.custom before v0.46.0), so callers
+// may treat it as a warning.
+type ConfigError struct {
+	Keys []string
+	Err  error
+}
+
+func (e *ConfigError) Error() string {
+	return fmt.Sprintf("setting config %s: %v", strings.Join(e.Keys, ", "), e.Err)
+}
+
+func (e *ConfigError) Unwrap() error {
+	return e.Err
+}
+
+// ApplyConfig sets each bd config key in key order. Every key is attempted;
+// the ones that fail are reported together in a *ConfigError.
+func (b *Beads) ApplyConfig(config map[string]string) error {
+	keys := make([]string, 0, len(config))
+	for key := range config {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var failed []string
+	var errs []error
+	for _, key := range keys {
+		if err := b.SetConfig(key, config[key]); err != nil {
+			failed = append(failed, key)
+			errs = append(errs, err)
+		}
+	}
+	if len(failed) > 0 {
+		return &ConfigError{Keys: failed, Err: errors.Join(errs...)}
+	}
+	return nil
+}
+
+// resolvedBeadsDir returns the .beads directory this wrapper's database
+// lives in: the explicit beadsDir if set, otherwise the working directory's
+// .beads with redirects followed. Files gt keeps beside the database belong
+// here, so that a worktree and its rig share them.
+func (b *Beads) resolvedBeadsDir() string {
+	if b.beadsDir != "" {
+		return b.beadsDir
+	}
+	return ResolveBeadsDir(b.workDir)
 }
 
 // run executes a bd command and returns stdout.
 func (b *Beads) run(args ...string) ([]byte, error) {
+	return b.runContext(context.Background(), args...)
+}
+
+// runContext is run with a context: if ctx ends first, bd is killed and
+// waited for, and ctx's error is returned.
+func (b *Beads) runContext(ctx context.Context, args ...string) ([]byte, error) {
 	// Use --allow-stale to prevent failures when db is out of sync with JSONL
 	// (e.g., after daemon is killed during shutdown before syncing).
 	fullArgs := append([]string{"--allow-stale"}, args...)
 
 	// Always explicitly set BEADS_DIR to prevent inherited env vars from
-	// causing prefix mismatches. Use explicit beadsDir if set, otherwise
-	// resolve from working directory.
-	beadsDir := b.beadsDir
-	if beadsDir == "" {
-		beadsDir = ResolveBeadsDir(b.workDir)
-	}
+	// causing prefix mismatches.
+	beadsDir := b.resolvedBeadsDir()
 
 	// In isolated mode, use --db flag to force specific database path
 	// This bypasses bd's routing logic that can redirect to .beads-planning
@@ -174,8 +304,10 @@ func (b *Beads) run(args ...string) ([]byte, error) {
 		fullArgs = append([]string{"--db", beadsDB}, fullArgs...)
 	}
 
-	cmd := exec.Command("bd", fullArgs...) //nolint:gosec // G204: bd is a trusted internal tool
+	cmd := exec.CommandContext(ctx, "bd", fullArgs...) //nolint:gosec // G204: bd is a trusted internal tool
 	cmd.Dir = b.workDir
+	// Don't let a killed bd's orphaned children hold its output open
+	cmd.WaitDelay = time.Second
 
 	// Build environment: filter beads env vars when in isolated mode (tests)
 	// to prevent routing to production databases.
@@ -192,15 +324,15 @@ func (b *Beads) run(args ...string) ([]byte, error) {
 	cmd.Stderr = &stderr
 
 	err := cmd.Run()
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return nil, fmt.Errorf("bd %s: %w", strings.Join(args, " "), ctxErr)
+	}
 	if err != nil {
 		return nil, b.wrapError(err, stderr.String(), args)
 	}
 
-	// Handle bd --no-daemon exit code 0 bug: when issue not found,
-	// --no-daemon exits 0 but writes error to stderr with empty stdout.
-	// Detect this case and treat as error to avoid JSON parse failures.
-	if stdout.Len() == 0 && stderr.Len() > 0 {
-		return nil, b.wrapError(fmt.Errorf("command produced no output"), stderr.String(), args)
+	if err := checkNoDaemonOutput(stdout.Bytes(), stderr.Bytes(), args); err != nil {
+		return nil, err
 	}
 
 	return stdout.Bytes(), nil
@@ -218,6 +350,11 @@ func (b *Beads) Run(args ...string) ([]byte, error) {
 // Exception: ErrNotInstalled (exec.ErrNotFound) and ErrNotFound (issue lookup) are
 // acceptable as they enable basic error handling without decision-making.
 func (b *Beads) wrapError(err error, stderr string, args []string) error {
+	return wrapBDError(err, stderr, args)
+}
+
+// wrapBDError implements wrapError for callers without a Beads instance.
+func wrapBDError(err error, stderr string, args []string) error {
 	stderr = strings.TrimSpace(stderr)
 
 	// Check for bd not installed
@@ -285,6 +422,21 @@ func (b *Beads) List(opts ListOptions) ([]*Issue, error) {
 	if opts.NoAssignee {
 		args = append(args, "--no-assignee")
 	}
+	// bd applies --limit before the client-side filters and sort below, so
+	// with either set the limit is applied here after them instead
+	clientSide := opts.CreatedBy != "" || opts.HasDependencies != nil || opts.HasDependents != nil ||
+		opts.MinBlockedBy > 0 || opts.SortBy != ""
+	limit := opts.Limit
+	if clientSide {
+		limit = 0
+	}
+	capped := limit == 0 && !opts.NoCap && ListCap > 0
+	if capped {
+		// Ask for one past the cap so truncation can be detected
+		args = append(args, fmt.Sprintf("--limit=%d", ListCap+1))
+	} else {
+		args = append(args, fmt.Sprintf("--limit=%d", limit))
+	}
 
 	out, err := b.run(args...)
 	if err != nil {
@@ -295,10 +447,68 @@ func (b *Beads) List(opts ListOptions) ([]*Issue, error) {
 	if err := json.Unmarshal(out, &issues); err != nil {
 		return nil, fmt.Errorf("parsing bd list output: %w", err)
 	}
+	if capped && len(issues) > ListCap {
+		fmt.Fprintf(os.Stderr, "Warning: bd list returned more than %d issues, truncating (use ListOptions.NoCap to list all)\n", ListCap)
+		issues = issues[:ListCap]
+	}
 
+	if clientSide {
+		filtered := issues[:0]
+		for _, issue := range issues {
+			if matchesClientFilters(issue, opts) {
+				filtered = append(filtered, issue)
+			}
+		}
+		issues = filtered
+	}
+
+	if opts.SortBy != "" {
+		if err := sortIssues(issues, opts.SortBy, opts.SortDesc); err != nil {
+			return nil, err
+		}
+	}
+
+	if clientSide && opts.Limit > 0 && len(issues) > opts.Limit {
+		issues = issues[:opts.Limit]
+	}
 	return issues, nil
 }
 
+// matchesClientFilters reports whether issue passes the ListOptions filters
+// bd list does not support.
+func matchesClientFilters(issue *Issue, opts ListOptions) bool {
+	if opts.CreatedBy != "" && issue.CreatedBy != opts.CreatedBy {
+		return false
+	}
+	if opts.HasDependencies != nil && (issue.DependencyCount > 0) != *opts.HasDependencies {
+		return false
+	}
+	if opts.HasDependents != nil && (issue.DependentCount > 0) != *opts.HasDependents {
+		return false
+	}
+	return issue.BlockedByCount >= opts.MinBlockedBy
+}
+
+// ListUpdatedSince returns issues matching opts that were updated after
+// since, for incremental refreshes. Filtering is done client-side on
+// UpdatedAt; issues with an unparseable UpdatedAt are included so that
+// nothing is silently missed.
+func (b *Beads) ListUpdatedSince(since time.Time, opts ListOptions) ([]*Issue, error) {
+	issues, err := b.List(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var updated []*Issue
+	for _, issue := range issues {
+		t, err := time.Parse(time.RFC3339, issue.UpdatedAt)
+		if err != nil || t.After(since) {
+			updated = append(updated, issue)
+		}
+	}
+	return updated, nil
+}
+
 // ListByAssignee returns all issues assigned to a specific assignee.
 // The assignee is typically in the format "rig/polecatName" (e.g., "gastown/Toast").
 func (b *Beads) ListByAssignee(assignee string) ([]*Issue, error) {
@@ -389,7 +599,9 @@ func (b *Beads) Show(id string) (*Issue, error) {
 		return nil, ErrNotFound
 	}
 
-	return issues[0], nil
+	issue := issues[0]
+	issue.TracksParents = trackingParents(issue)
+	return issue, nil
 }
 
 // ShowMultiple fetches multiple issues by ID in a single bd call.
@@ -420,6 +632,75 @@ func (b *Beads) ShowMultiple(ids []string) (map[string]*Issue, error) {
 	return result, nil
 }
 
+// ShowOrdered fetches multiple issues in a single bd call, returning them in
+// request order along with the IDs that were not found. Duplicate IDs are
+// reported once, at their first position. Unlike ShowMultiple, a bd failure
+// other than not-found is returned as an error.
+func (b *Beads) ShowOrdered(ids []string) ([]*Issue, []string, error) {
+	var unique []string
+	seen := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+
+	found, err := b.showFound(unique)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	issues := make([]*Issue, 0, len(unique))
+	var missing []string
+	for _, id := range unique {
+		if issue, ok := found[id]; ok {
+			issues = append(issues, issue)
+		} else {
+			missing = append(missing, id)
+		}
+	}
+	return issues, missing, nil
+}
+
+// showFound is ShowMultiple for callers that must tell a missing issue from
+// a failed lookup: bd failures are returned, and only IDs bd reports as not
+// found are left out of the map. If bd rejects the batch because an ID is
+// not found, the IDs are shown one at a time to find the rest.
+func (b *Beads) showFound(ids []string) (map[string]*Issue, error) {
+	result := make(map[string]*Issue, len(ids))
+	if len(ids) == 0 {
+		return result, nil
+	}
+
+	out, err := b.run(append([]string{"show", "--json"}, ids...)...)
+	if errors.Is(err, ErrNotFound) {
+		for _, id := range ids {
+			issue, err := b.Show(id)
+			if errors.Is(err, ErrNotFound) {
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+			result[id] = issue
+		}
+		return result, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var issues []*Issue
+	if err := json.Unmarshal(out, &issues); err != nil {
+		return nil, fmt.Errorf("parsing bd show output: %w", err)
+	}
+	for _, issue := range issues {
+		result[issue.ID] = issue
+	}
+	return result, nil
+}
+
 // Blocked returns issues that are blocked by dependencies.
 func (b *Beads) Blocked() ([]*Issue, error) {
 	out, err := b.run("blocked", "--json")
@@ -439,14 +720,21 @@ func (b *Beads) Blocked() ([]*Issue, error) {
 // If opts.Actor is empty, it defaults to the BD_ACTOR environment variable.
 // This ensures created_by is populated for issue provenance tracking.
 func (b *Beads) Create(opts CreateOptions) (*Issue, error) {
+	if opts.DryRun {
+		return nil, b.Validate(opts)
+	}
+
 	args := []string{"create", "--json"}
 
 	if opts.Title != "" {
 		args = append(args, "--title="+opts.Title)
 	}
-	// Type is deprecated: convert to gt:<type> label
-	if opts.Type != "" {
-		args = append(args, "--labels=gt:"+opts.Type)
+	labels := createLabels(opts)
+	if err := b.checkLabels(labels); err != nil {
+		return nil, err
+	}
+	for _, label := range labels {
+		args = append(args, "--labels="+label)
 	}
 	if opts.Priority >= 0 {
 		args = append(args, fmt.Sprintf("--priority=%d", opts.Priority))
@@ -457,9 +745,15 @@ func (b *Beads) Create(opts CreateOptions) (*Issue, error) {
 	if opts.Parent != "" {
 		args = append(args, "--parent="+opts.Parent)
 	}
+	if opts.Assignee != "" {
+		args = append(args, "--assignee="+opts.Assignee)
+	}
 	if opts.Ephemeral {
 		args = append(args, "--ephemeral")
 	}
+	if opts.Estimate > 0 {
+		args = append(args, fmt.Sprintf("--estimate=%d", opts.Estimate))
+	}
 	// Default Actor from BD_ACTOR env var if not specified
 	// Uses getActor() to respect isolated mode (tests)
 	actor := opts.Actor
@@ -480,13 +774,40 @@ func (b *Beads) Create(opts CreateOptions) (*Issue, error) {
 		return nil, fmt.Errorf("parsing bd create output: %w", err)
 	}
 
+	if err := b.linkDependencies(issue.ID, opts); err != nil {
+		if _, delErr := b.run("delete", issue.ID, "--hard", "--force"); delErr != nil {
+			return nil, fmt.Errorf("%w (rollback of %s also failed: %v)", err, issue.ID, delErr)
+		}
+		return nil, err
+	}
+
 	return &issue, nil
 }
 
+// linkDependencies adds the dependencies requested in opts to a new issue.
+func (b *Beads) linkDependencies(id string, opts CreateOptions) error {
+	deps := make([]TypedDep, 0, len(opts.DependsOn)+len(opts.DependsOnTyped))
+	for _, dep := range opts.DependsOn {
+		deps = append(deps, TypedDep{ID: dep, Type: DepTypeBlocks})
+	}
+	deps = append(deps, opts.DependsOnTyped...)
+
+	for _, dep := range deps {
+		if err := b.AddTypedDependency(id, dep.ID, dep.Type); err != nil {
+			return fmt.Errorf("linking %s to %s (%s): %w", id, dep.ID, dep.Type, err)
+		}
+	}
+	return nil
+}
+
 // CreateWithID creates an issue with a specific ID.
 // This is useful for agent beads, role beads, and other beads that need
 // deterministic IDs rather than auto-generated ones.
 func (b *Beads) CreateWithID(id string, opts CreateOptions) (*Issue, error) {
+	if opts.DryRun {
+		return nil, b.ValidateWithID(id, opts)
+	}
+
 	args := []string{"create", "--json", "--id=" + id}
 	if NeedsForceForID(id) {
 		args = append(args, "--force")
@@ -495,9 +816,12 @@ func (b *Beads) CreateWithID(id string, opts CreateOptions) (*Issue, error) {
 	if opts.Title != "" {
 		args = append(args, "--title="+opts.Title)
 	}
-	// Type is deprecated: convert to gt:<type> label
-	if opts.Type != "" {
-		args = append(args, "--labels=gt:"+opts.Type)
+	labels := createLabels(opts)
+	if err := b.checkLabels(labels); err != nil {
+		return nil, err
+	}
+	for _, label := range labels {
+		args = append(args, "--labels="+label)
 	}
 	if opts.Priority >= 0 {
 		args = append(args, fmt.Sprintf("--priority=%d", opts.Priority))
@@ -531,6 +855,33 @@ func (b *Beads) CreateWithID(id string, opts CreateOptions) (*Issue, error) {
 	return &issue, nil
 }
 
+// createLabels returns the labels a new issue starts with: gt:<type> (Type
+// is deprecated in favor of the label), the TTL labels, then opts.Labels.
+// Labels are trimmed, and empty or duplicate labels dropped, so a Type also
+// passed as a label is only applied once.
+func createLabels(opts CreateOptions) []string {
+	var labels []string
+	if opts.Type != "" {
+		labels = append(labels, "gt:"+opts.Type)
+	}
+	if opts.TTL > 0 {
+		labels = append(labels, LabelTTL, expiresLabel(now().Add(opts.TTL)))
+	}
+	labels = append(labels, opts.Labels...)
+
+	seen := make(map[string]bool, len(labels))
+	normalized := labels[:0]
+	for _, label := range labels {
+		label = strings.TrimSpace(label)
+		if label == "" || seen[label] {
+			continue
+		}
+		seen[label] = true
+		normalized = append(normalized, label)
+	}
+	return normalized
+}
+
 // Update updates an existing issue.
 func (b *Beads) Update(id string, opts UpdateOptions) error {
 	args := []string{"update", id}
@@ -556,6 +907,9 @@ func (b *Beads) Update(id string, opts UpdateOptions) error {
 			args = append(args, "--set-labels="+label)
 		}
 	} else {
+		if err := b.checkLabels(opts.AddLabels); err != nil {
+			return err
+		}
 		for _, label := range opts.AddLabels {
 			args = append(args, "--add-label="+label)
 		}
@@ -564,8 +918,15 @@ func (b *Beads) Update(id string, opts UpdateOptions) error {
 		}
 	}
 
-	_, err := b.run(args...)
-	return err
+	if _, err := b.run(args...); err != nil {
+		return err
+	}
+	var status string
+	if opts.Status != nil {
+		status = *opts.Status
+	}
+	b.logTransition(id, status, opts.Assignee)
+	return nil
 }
 
 // Close closes one or more issues.
@@ -583,8 +944,13 @@ func (b *Beads) Close(ids ...string) error {
 		args = append(args, "--session="+sessionID)
 	}
 
-	_, err := b.run(args...)
-	return err
+	if _, err := b.run(args...); err != nil {
+		return err
+	}
+	for _, id := range ids {
+		b.logTransition(id, "closed", nil)
+	}
+	return nil
 }
 
 // CloseWithReason closes one or more issues with a reason.
@@ -603,8 +969,13 @@ func (b *Beads) CloseWithReason(reason string, ids ...string) error {
 		args = append(args, "--session="+sessionID)
 	}
 
-	_, err := b.run(args...)
-	return err
+	if _, err := b.run(args...); err != nil {
+		return err
+	}
+	for _, id := range ids {
+		b.logTransition(id, "closed", nil)
+	}
+	return nil
 }
 
 // Release moves an in_progress issue back to open status.
@@ -624,16 +995,37 @@ func (b *Beads) ReleaseWithReason(id, reason string) error {
 		args = append(args, "--notes=Released: "+reason)
 	}
 
-	_, err := b.run(args...)
-	return err
+	if _, err := b.run(args...); err != nil {
+		return err
+	}
+	unassigned := ""
+	b.logTransition(id, "open", &unassigned)
+	return nil
 }
 
+// Dependency types accepted by bd dep add --type.
+const (
+	DepTypeBlocks = "blocks" // Blocking: issue is not ready until dependsOn closes
+	DepTypeTracks = "tracks" // Non-blocking: issue follows dependsOn (convoys, guidance)
+
+	// DepTypeParentChild links a child to its parent (bd create --parent).
+	DepTypeParentChild = "parent-child"
+)
+
 // AddDependency adds a dependency: issue depends on dependsOn.
 func (b *Beads) AddDependency(issue, dependsOn string) error {
 	_, err := b.run("dep", "add", issue, dependsOn)
 	return err
 }
 
+// AddTypedDependency adds a dependency of the given type (see DepType*).
+// Unlike AddDependency, non-blocking types such as DepTypeTracks leave
+// dependsOn visible in bd ready.
+func (b *Beads) AddTypedDependency(issue, dependsOn, depType string) error {
+	_, err := b.run("dep", "add", issue, dependsOn, "--type="+depType)
+	return err
+}
+
 // RemoveDependency removes a dependency.
 func (b *Beads) RemoveDependency(issue, dependsOn string) error {
 	_, err := b.run("dep", "remove", issue, dependsOn)
@@ -654,7 +1046,12 @@ func (b *Beads) SyncFromMain() error {
 
 // GetSyncStatus returns the sync status without performing a sync.
 func (b *Beads) GetSyncStatus() (*SyncStatus, error) {
-	out, err := b.run("sync", "--status", "--json")
+	return b.syncStatus(context.Background())
+}
+
+// syncStatus is GetSyncStatus bounded by ctx.
+func (b *Beads) syncStatus(ctx context.Context) (*SyncStatus, error) {
+	out, err := b.runContext(ctx, "sync", "--status", "--json")
 	if err != nil {
 		// If sync branch doesn't exist, return empty status
 		if strings.Contains(err.Error(), "does not exist") {
@@ -671,6 +1068,26 @@ func (b *Beads) GetSyncStatus() (*SyncStatus, error) {
 	return &status, nil
 }
 
+// GetConfig returns the value of a bd config key, trimmed of whitespace.
+// An unset key yields an empty string.
+func (b *Beads) GetConfig(key string) (string, error) {
+	out, err := b.run("config", "get", key)
+	if err != nil {
+		return "", err
+	}
+	value := strings.TrimSpace(string(out))
+	if strings.Contains(value, "(not set)") {
+		return "", nil
+	}
+	return value, nil
+}
+
+// SetConfig sets a bd config key.
+func (b *Beads) SetConfig(key, value string) error {
+	_, err := b.run("config", "set", key, value)
+	return err
+}
+
 // Stats returns repository statistics.
 func (b *Beads) Stats() (string, error) {
 	out, err := b.run("stats")
@@ -682,6 +1099,7 @@ func (b *Beads) Stats() (string, error) {
 
 // IsBeadsRepo checks if the working directory is a beads repository.
 // ZFC: Check file existence directly instead of parsing bd errors.
+// Redirect-only worktrees count as repos; use BeadsRepoKind to tell them apart.
 func (b *Beads) IsBeadsRepo() bool {
 	beadsDir := ResolveBeadsDir(b.workDir)
 	info, err := os.Stat(beadsDir)
@@ -772,3 +1190,32 @@ func ProvisionPrimeMDForWorktree(worktreePath string) error {
 	// Provision PRIME.md in the target directory
 	return ProvisionPrimeMD(beadsDir)
 }
+
+// PrimeVars are substituted into PRIME.md content by Prime. Each field
+// replaces its placeholder: {{town}}, {{rig}}, and {{role}}.
+type PrimeVars struct {
+	Town string
+	Rig  string
+	Role string
+}
+
+// Prime returns the Gas Town worker context for this beads directory.
+// A town-customized PRIME.md (following any redirect) takes precedence over
+// the built-in content; template placeholders are filled from vars.
+func (b *Beads) Prime(vars PrimeVars) string {
+	content := primeContent
+	primePath := filepath.Join(ResolveBeadsDir(b.workDir), "PRIME.md")
+	if data, err := os.ReadFile(primePath); err == nil { //nolint:gosec // G304: path is constructed internally
+		content = string(data)
+	}
+	return renderPrime(content, vars)
+}
+
+// renderPrime substitutes PrimeVars placeholders in PRIME.md content.
+func renderPrime(content string, vars PrimeVars) string {
+	return strings.NewReplacer(
+		"{{town}}", vars.Town,
+		"{{rig}}", vars.Rig,
+		"{{role}}", vars.Role,
+	).Replace(content)
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 )
 
@@ -173,6 +174,8 @@ func (b *Beads) CreateAgentBead(id, title string, fields *AgentFields) (*Issue,
 		if _, err := b.run("slot", "set", id, "hook", fields.HookBead); err != nil {
 			// Non-fatal: warn but continue - description text has the backup
 			fmt.Printf("Warning: could not set hook slot: %v\n", err)
+		} else {
+			b.logHookEvent(id, HookActionSet, fields.HookBead)
 		}
 	}
 
@@ -239,6 +242,8 @@ func (b *Beads) CreateOrReopenAgentBead(id, title string, fields *AgentFields) (
 		if _, err := b.run("slot", "set", id, "hook", fields.HookBead); err != nil {
 			// Non-fatal: warn but continue
 			fmt.Printf("Warning: could not set hook slot: %v\n", err)
+		} else {
+			b.logHookEvent(id, HookActionSet, fields.HookBead)
 		}
 	}
 
@@ -282,12 +287,14 @@ func (b *Beads) UpdateAgentState(id string, state string, hookBead *string) erro
 					return fmt.Errorf("setting hook: %w", err)
 				}
 			}
+			b.logHookEvent(id, HookActionSet, *hookBead)
 		} else {
 			// Clear the hook
 			_, err = b.run("slot", "clear", id, "hook")
 			if err != nil {
 				return fmt.Errorf("clearing hook: %w", err)
 			}
+			b.logHookEvent(id, HookActionClear, "")
 		}
 	}
 
@@ -313,6 +320,7 @@ func (b *Beads) SetHookBead(agentBeadID, hookBeadID string) error {
 			return fmt.Errorf("setting hook: %w", err)
 		}
 	}
+	b.logHookEvent(agentBeadID, HookActionSet, hookBeadID)
 	return nil
 }
 
@@ -323,6 +331,7 @@ func (b *Beads) ClearHookBead(agentBeadID string) error {
 	if err != nil {
 		return fmt.Errorf("clearing hook: %w", err)
 	}
+	b.logHookEvent(agentBeadID, HookActionClear, "")
 	return nil
 }
 
@@ -512,3 +521,48 @@ func (b *Beads) ListAgentBeads() (map[string]*Issue, error) {
 
 	return result, nil
 }
+
+// terminalAgentStates are agent_state values of agents that will not take
+// new work.
+var terminalAgentStates = map[string]bool{
+	"done":   true,
+	"closed": true,
+	"dead":   true,
+	"nuked":  true,
+}
+
+// IdleAgents returns a rig's open agent beads with nothing on their hook and
+// a non-terminal agent_state, sorted by ID. Dispatchers use it to reuse an
+// existing agent before spawning a new one.
+func (b *Beads) IdleAgents(rigName string) ([]*Issue, error) {
+	agents, err := b.List(ListOptions{Label: "gt:agent", Status: "open", Priority: -1})
+	if err != nil {
+		return nil, err
+	}
+
+	var idle []*Issue
+	for _, agent := range agents {
+		fields := ParseAgentFields(agent.Description)
+		rig := fields.Rig
+		if rig == "" {
+			rig, _, _, _ = ParseAgentBeadID(agent.ID)
+		}
+		if rig != rigName {
+			continue
+		}
+		if agent.HookBead != "" || fields.HookBead != "" {
+			continue
+		}
+		state := agent.AgentState
+		if state == "" {
+			state = fields.AgentState
+		}
+		if terminalAgentStates[state] {
+			continue
+		}
+		idle = append(idle, agent)
+	}
+
+	sort.Slice(idle, func(i, j int) bool { return idle[i].ID < idle[j].ID })
+	return idle, nil
+}
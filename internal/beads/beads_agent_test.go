@@ -0,0 +1,40 @@
+package beads
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestIdleAgents(t *testing.T) {
+	installBDStub(t, `
+case "$cmd" in
+  list)
+    cat <<'JSON'
+[
+ {"id":"gt-gastown-crew-max","status":"open","labels":["gt:agent"]},
+ {"id":"gt-gastown-crew-joe","status":"open","labels":["gt:agent"],"hook_bead":"gt-work1"},
+ {"id":"gt-gastown-polecat-ace","status":"open","labels":["gt:agent"],"description":"role_type: polecat\nrig: gastown\nhook_bead: gt-work2"},
+ {"id":"gt-gastown-polecat-bee","status":"open","labels":["gt:agent"],"agent_state":"done"},
+ {"id":"gt-gastown-crew-amy","status":"open","labels":["gt:agent"],"agent_state":"working"},
+ {"id":"gt-beads-crew-zed","status":"open","labels":["gt:agent"]},
+ {"id":"gt-mayor","status":"open","labels":["gt:agent"]}
+]
+JSON
+    ;;
+esac
+`)
+	b := New(t.TempDir())
+
+	idle, err := b.IdleAgents("gastown")
+	if err != nil {
+		t.Fatalf("IdleAgents: %v", err)
+	}
+	var ids []string
+	for _, agent := range idle {
+		ids = append(ids, agent.ID)
+	}
+	want := "gt-gastown-crew-amy,gt-gastown-crew-max"
+	if got := strings.Join(ids, ","); got != want {
+		t.Errorf("IdleAgents = %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,81 @@
+// Package beads provides blocking-chain analysis to explain why work isn't ready.
+package beads
+
+// isBlockingDep reports whether a dependency blocks readiness: a blocks
+// dependency (untyped counts as blocks) that is not closed.
+func isBlockingDep(dep IssueDep) bool {
+	return (dep.DependencyType == "" || dep.DependencyType == DepTypeBlocks) && dep.Status != "closed"
+}
+
+// BlockingChain explains why an issue is not ready. Each returned path
+// starts at one of the issue's open blockers and follows open blocks
+// dependencies down to a root blocker, one with no open blockers of its
+// own. An issue with no open blockers returns no paths. A cycle ends the
+// path before it would repeat an issue, and a path that joins a chain
+// already listed ends at the shared blocker.
+func (b *Beads) BlockingChain(id string) ([][]string, error) {
+	blockers := make(map[string][]string)
+	fetched := make(map[string]bool)
+
+	frontier := []string{id}
+	for len(frontier) > 0 {
+		issues, err := b.ShowMultiple(frontier)
+		if err != nil {
+			return nil, err
+		}
+		if !fetched[id] && issues[id] == nil {
+			return nil, ErrNotFound
+		}
+
+		var next []string
+		for _, fid := range frontier {
+			fetched[fid] = true
+			issue := issues[fid]
+			if issue == nil {
+				continue
+			}
+			for _, dep := range issue.Dependencies {
+				if !isBlockingDep(dep) {
+					continue
+				}
+				blockers[fid] = append(blockers[fid], dep.ID)
+				if !fetched[dep.ID] {
+					fetched[dep.ID] = true
+					next = append(next, dep.ID)
+				}
+			}
+		}
+		frontier = next
+	}
+
+	// Each blocker's chain is walked once. A later path that reaches an
+	// already-walked blocker ends there instead of repeating its chain, so
+	// diamond-shaped dependencies stay linear in the number of edges.
+	var paths [][]string
+	walked := map[string]bool{id: true}
+	var walk func(path []string, onPath map[string]bool)
+	walk = func(path []string, onPath map[string]bool) {
+		last := path[len(path)-1]
+		var extended bool
+		for _, blocker := range blockers[last] {
+			if onPath[blocker] {
+				continue
+			}
+			extended = true
+			if walked[blocker] {
+				paths = append(paths, append(append([]string(nil), path[1:]...), blocker))
+				continue
+			}
+			walked[blocker] = true
+			onPath[blocker] = true
+			walk(append(path, blocker), onPath)
+			delete(onPath, blocker)
+		}
+		if !extended && len(path) > 1 {
+			paths = append(paths, append([]string(nil), path[1:]...))
+		}
+	}
+	walk([]string{id}, map[string]bool{id: true})
+
+	return paths, nil
+}
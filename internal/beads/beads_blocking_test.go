@@ -0,0 +1,115 @@
+package beads
+
+import (
+	"reflect"
+	"testing"
+)
+
+// chainStub serves gt-a blocked by gt-b and gt-x; gt-b blocked by gt-c;
+// gt-x is closed, and gt-c is closed when $C_CLOSED is set. gt-a also has a
+// non-blocking tracks dependency that must be ignored.
+const chainStub = `
+c_status=open
+[ -n "$C_CLOSED" ] && c_status=closed
+case "$cmd" in
+  show)
+    out=""
+    for id in "$@"; do
+      case "$id" in
+        gt-a) obj='{"id":"gt-a","status":"open","dependencies":[{"id":"gt-b","status":"open","dependency_type":"blocks"},{"id":"gt-x","status":"closed","dependency_type":"blocks"},{"id":"hq-cv-1","status":"open","dependency_type":"tracks"}]}' ;;
+        gt-b) obj='{"id":"gt-b","status":"open","dependencies":[{"id":"gt-c","status":"'$c_status'","dependency_type":"blocks"}]}' ;;
+        gt-c) obj='{"id":"gt-c","status":"'$c_status'"}' ;;
+        *) continue ;;
+      esac
+      out="${out:+$out,}$obj"
+    done
+    printf '[%s]\n' "$out"
+    ;;
+esac
+`
+
+func TestBlockingChain(t *testing.T) {
+	installBDStub(t, chainStub)
+	b := New(t.TempDir())
+
+	paths, err := b.BlockingChain("gt-a")
+	if err != nil {
+		t.Fatalf("BlockingChain: %v", err)
+	}
+	if want := [][]string{{"gt-b", "gt-c"}}; !reflect.DeepEqual(paths, want) {
+		t.Errorf("BlockingChain() = %v, want %v", paths, want)
+	}
+
+	// Closing the deepest blocker makes gt-b the root blocker
+	t.Setenv("C_CLOSED", "1")
+	paths, err = b.BlockingChain("gt-a")
+	if err != nil {
+		t.Fatalf("BlockingChain: %v", err)
+	}
+	if want := [][]string{{"gt-b"}}; !reflect.DeepEqual(paths, want) {
+		t.Errorf("after closing gt-c, BlockingChain() = %v, want %v", paths, want)
+	}
+
+	// gt-b itself is now unblocked
+	paths, err = b.BlockingChain("gt-b")
+	if err != nil {
+		t.Fatalf("BlockingChain: %v", err)
+	}
+	if len(paths) != 0 {
+		t.Errorf("BlockingChain(gt-b) = %v, want none", paths)
+	}
+}
+
+func TestBlockingChainNotFound(t *testing.T) {
+	installBDStub(t, chainStub)
+	if _, err := New(t.TempDir()).BlockingChain("gt-missing"); err != ErrNotFound {
+		t.Errorf("BlockingChain(missing) error = %v, want ErrNotFound", err)
+	}
+}
+
+// TestBlockingChainDiamonds tests that shared blockers are walked once: a
+// stack of diamonds, each level blocked by two issues that share the next
+// level's blocker, yields one path per diamond rather than one per route.
+func TestBlockingChainDiamonds(t *testing.T) {
+	// gt-0 is blocked by gt-1l and gt-1r, both blocked by gt-1; gt-1 by
+	// gt-2l and gt-2r, both blocked by gt-2; and so on down to gt-20.
+	calls := installBDStub(t, `
+case "$cmd" in
+  show)
+    out=""
+    for id in "$@"; do
+      case "$id" in gt-*) ;; *) continue ;; esac
+      n=${id#gt-}
+      case "$n" in
+        *l|*r) next=${n%?}; obj='{"id":"'$id'","status":"open","dependencies":[{"id":"gt-'$next'","status":"open","dependency_type":"blocks"}]}' ;;
+        20) obj='{"id":"gt-20","status":"open"}' ;;
+        *) next=$((n+1)); obj='{"id":"'$id'","status":"open","dependencies":[{"id":"gt-'$next'l","status":"open","dependency_type":"blocks"},{"id":"gt-'$next'r","status":"open","dependency_type":"blocks"}]}' ;;
+      esac
+      out="${out:+$out,}$obj"
+    done
+    printf '[%s]\n' "$out"
+    ;;
+esac
+`)
+
+	paths, err := New(t.TempDir()).BlockingChain("gt-0")
+	if err != nil {
+		t.Fatalf("BlockingChain: %v", err)
+	}
+	// 2^20 routes, but one full path plus one short path per diamond
+	if len(paths) != 21 {
+		t.Fatalf("BlockingChain returned %d paths, want 21", len(paths))
+	}
+	if first := paths[0]; len(first) != 40 || first[0] != "gt-1l" || first[39] != "gt-20" {
+		t.Errorf("first path = %v, want the full chain from gt-1l down to gt-20", first)
+	}
+	for _, path := range paths[1:] {
+		n := len(path)
+		if n < 2 || path[n-2] != path[n-1]+"r" {
+			t.Errorf("path %v should end at the shared blocker of its diamond", path)
+		}
+	}
+	if n := len(calls()); n > 41 {
+		t.Errorf("bd show ran %d times, want one per level", n)
+	}
+}
@@ -0,0 +1,58 @@
+// Package beads provides bd capability probing.
+package beads
+
+import (
+	"errors"
+	"os/exec"
+	"strings"
+	"sync"
+)
+
+// ErrUnsupported is returned by optional operations when the installed bd
+// predates the command or flag they need.
+var ErrUnsupported = errors.New("not supported by this bd version")
+
+// commandHelp caches each subcommand's --help output, keyed by bd binary path
+// and command name, so each subcommand is probed at most once per process.
+// A nil entry records a command bd does not have.
+var commandHelp sync.Map
+
+// commandHelpText returns the subcommand's --help output, and false if bd
+// does not have the subcommand.
+func (b *Beads) commandHelpText(name string) (string, bool) {
+	path, err := exec.LookPath("bd")
+	if err != nil {
+		return "", false
+	}
+	key := path + "\x00" + name
+	if help, ok := commandHelp.Load(key); ok {
+		if help == nil {
+			return "", false
+		}
+		return help.(string), true
+	}
+
+	// bd rejects unknown subcommands even with --help
+	out, err := b.run(name, "--help")
+	if err != nil {
+		commandHelp.Store(key, nil)
+		return "", false
+	}
+	commandHelp.Store(key, string(out))
+	return string(out), true
+}
+
+// SupportsCommand reports whether the installed bd has the given subcommand.
+// Optional features use it to tell "this bd predates the command" apart from
+// a real failure, which they should surface rather than swallow.
+func (b *Beads) SupportsCommand(name string) bool {
+	_, ok := b.commandHelpText(name)
+	return ok
+}
+
+// SupportsFlag reports whether the installed bd's subcommand accepts the
+// given flag (e.g., "--status"), judged from the subcommand's --help output.
+func (b *Beads) SupportsFlag(command, flag string) bool {
+	help, ok := b.commandHelpText(command)
+	return ok && strings.Contains(help, flag)
+}
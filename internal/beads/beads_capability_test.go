@@ -0,0 +1,49 @@
+package beads
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSupportsCommand(t *testing.T) {
+	calls := installBDStub(t, `
+case "$cmd" in
+  migrate)
+    case " $* " in
+      *" --help "*) echo "Usage: bd migrate [--status]" ;;
+      *) echo "database is locked" >&2; exit 1 ;;
+    esac
+    ;;
+  *)
+    echo "Error: unknown command \"$cmd\" for \"bd\"" >&2
+    exit 1
+    ;;
+esac
+`)
+	b := New(t.TempDir())
+
+	if b.SupportsCommand("frobnicate") {
+		t.Error("SupportsCommand(frobnicate) = true, want false")
+	}
+	if !b.SupportsCommand("migrate") {
+		t.Fatal("SupportsCommand(migrate) = false, want true")
+	}
+
+	// Probes are cached
+	b.SupportsCommand("migrate")
+	probes := 0
+	for _, call := range calls() {
+		if hasCall([]string{call}, "migrate", "--help") {
+			probes++
+		}
+	}
+	if probes != 1 {
+		t.Errorf("migrate probed %d times, want 1", probes)
+	}
+
+	// A supported command's real failure is not mistaken for a missing one
+	_, err := b.MigrationStatus()
+	if err == nil || errors.Is(err, ErrNotFound) {
+		t.Errorf("MigrationStatus err = %v, want the bd failure", err)
+	}
+}
@@ -0,0 +1,106 @@
+// Package beads provides atomic bead claiming for dispatch.
+package beads
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/gofrs/flock"
+)
+
+// claimLockFile is the lock serializing claims within one beads database.
+const claimLockFile = "claim.lock"
+
+// claimLockTimeout bounds how long TryClaim waits for a concurrent claim.
+const claimLockTimeout = 10 * time.Second
+
+// TryClaim atomically hooks beadID to agentID if the bead is claimable: open
+// and unassigned, or already assigned to agentID. Returns false (and changes
+// nothing) if the bead is closed or held by another agent.
+//
+// bd has no compare-and-set update, so the check and the update run under an
+// exclusive file lock in the bead's .beads directory. Every dispatcher that
+// claims through TryClaim is serialized per database.
+func (b *Beads) TryClaim(beadID, agentID string) (bool, error) {
+	beadsDir := b.resolvedBeadsDir()
+	if err := os.MkdirAll(beadsDir, 0755); err != nil {
+		return false, fmt.Errorf("creating beads dir: %w", err)
+	}
+
+	lock := flock.New(filepath.Join(beadsDir, claimLockFile))
+	ctx, cancel := context.WithTimeout(context.Background(), claimLockTimeout)
+	defer cancel()
+	locked, err := lock.TryLockContext(ctx, 50*time.Millisecond)
+	if err != nil {
+		return false, fmt.Errorf("acquiring claim lock: %w", err)
+	}
+	if !locked {
+		return false, errors.New("acquiring claim lock: timed out")
+	}
+	defer func() { _ = lock.Unlock() }()
+
+	issue, err := b.Show(beadID)
+	if err != nil {
+		return false, err
+	}
+	if !claimable(issue, agentID) {
+		return false, nil
+	}
+
+	if err := b.Hook(beadID, agentID); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Hook puts beadID on agentID's hook (status=hooked, assignee=agentID)
+// without any claim check. The write uses --no-daemon so it reaches the
+// database directly instead of racing the daemon's socket, as agents read
+// their hook right after being slung. bd's stderr is returned in the error.
+func (b *Beads) Hook(beadID, agentID string) error {
+	status := StatusHooked
+	if _, err := b.run("--no-daemon", "update", beadID, "--status="+status, "--assignee="+agentID); err != nil {
+		return err
+	}
+	b.logTransition(beadID, status, &agentID)
+	return nil
+}
+
+// claimable reports whether agentID may take issue onto its hook.
+func claimable(issue *Issue, agentID string) bool {
+	if issue.Status == "closed" {
+		return false
+	}
+	if issue.Assignee == agentID {
+		return true
+	}
+	return issue.Assignee == "" && issue.Status == "open"
+}
+
+// CreateHooked creates a bead directly on agentID's hook (status=hooked,
+// assigned to agentID), for dispatchers that generate the work bead on the
+// fly. bd create cannot set a status, so the bead is created pre-assigned
+// and then hooked; if hooking fails the new bead is deleted rather than
+// left open for another agent to claim.
+func (b *Beads) CreateHooked(opts CreateOptions, agentID string) (*Issue, error) {
+	opts.Assignee = agentID
+	issue, err := b.Create(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := b.Hook(issue.ID, agentID); err != nil {
+		if _, delErr := b.run("delete", issue.ID, "--hard", "--force"); delErr != nil {
+			return nil, fmt.Errorf("hooking %s: %w (rollback also failed: %v)", issue.ID, err, delErr)
+		}
+		return nil, fmt.Errorf("hooking %s: %w", issue.ID, err)
+	}
+
+	issue.Status = StatusHooked
+	issue.Assignee = agentID
+	return issue, nil
+}
@@ -0,0 +1,175 @@
+package beads
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// installClaimStub installs a bd stub backed by a "status assignee" file,
+// supporting show and update for a single bead.
+func installClaimStub(t *testing.T, status, assignee string) string {
+	t.Helper()
+	state := filepath.Join(t.TempDir(), "state")
+	if err := os.WriteFile(state, []byte(status+" "+assignee+"\n"), 0644); err != nil {
+		t.Fatalf("write state: %v", err)
+	}
+	installBDStub(t, `STATE="`+state+`"
+read -r status assignee < "$STATE"
+case "$cmd" in
+  show)
+    printf '%s\n' "[{\"id\":\"$1\",\"status\":\"$status\",\"assignee\":\"$assignee\"}]"
+    ;;
+  update)
+    for arg in "$@"; do
+      case "$arg" in
+        --status=*) status="${arg#--status=}" ;;
+        --assignee=*) assignee="${arg#--assignee=}" ;;
+      esac
+    done
+    printf '%s %s\n' "$status" "$assignee" > "$STATE"
+    printf '%s\n' "updated"
+    ;;
+esac`)
+	return state
+}
+
+func readClaimState(t *testing.T, state string) string {
+	t.Helper()
+	data, err := os.ReadFile(state)
+	if err != nil {
+		t.Fatalf("read state: %v", err)
+	}
+	return strings.TrimSpace(string(data))
+}
+
+func TestTryClaim(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    string
+		assignee  string
+		want      bool
+		wantState string
+	}{
+		{"open unassigned", "open", "", true, "hooked gastown/polecats/Toast"},
+		{"already ours", "hooked", "gastown/polecats/Toast", true, "hooked gastown/polecats/Toast"},
+		{"open, assigned to us", "open", "gastown/polecats/Toast", true, "hooked gastown/polecats/Toast"},
+		{"held by another", "hooked", "gastown/polecats/Nux", false, "hooked gastown/polecats/Nux"},
+		{"closed", "closed", "", false, "closed"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := installClaimStub(t, tt.status, tt.assignee)
+			got, err := New(t.TempDir()).TryClaim("gt-abc", "gastown/polecats/Toast")
+			if err != nil {
+				t.Fatalf("TryClaim() error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("TryClaim() = %v, want %v", got, tt.want)
+			}
+			if s := readClaimState(t, state); s != tt.wantState {
+				t.Errorf("state = %q, want %q", s, tt.wantState)
+			}
+		})
+	}
+}
+
+func TestTryClaimConcurrent(t *testing.T) {
+	state := installClaimStub(t, "open", "")
+	workDir := t.TempDir()
+
+	agents := []string{"gastown/polecats/Toast", "beads/polecats/Nux", "gastown/polecats/Slit"}
+	won := make([]bool, len(agents))
+	var wg sync.WaitGroup
+	for i, agent := range agents {
+		wg.Add(1)
+		go func(i int, agent string) {
+			defer wg.Done()
+			ok, err := New(workDir).TryClaim("gt-abc", agent)
+			if err != nil {
+				t.Errorf("TryClaim(%s): %v", agent, err)
+			}
+			won[i] = ok
+		}(i, agent)
+	}
+	wg.Wait()
+
+	winner := ""
+	for i, ok := range won {
+		if ok {
+			if winner != "" {
+				t.Fatalf("both %s and %s claimed the bead", winner, agents[i])
+			}
+			winner = agents[i]
+		}
+	}
+	if winner == "" {
+		t.Fatal("no agent claimed the bead")
+	}
+	if s := readClaimState(t, state); s != "hooked "+winner {
+		t.Errorf("state = %q, want hooked by %s", s, winner)
+	}
+}
+
+func TestHook(t *testing.T) {
+	t.Run("writes without the daemon", func(t *testing.T) {
+		calls := installBDStub(t, `case "$cmd" in update) echo updated ;; esac`)
+		if err := New(t.TempDir()).Hook("gt-abc", "gastown/polecats/Toast"); err != nil {
+			t.Fatalf("Hook: %v", err)
+		}
+		if !hasCall(calls(), "--no-daemon update gt-abc", "--status=hooked", "--assignee=gastown/polecats/Toast") {
+			t.Errorf("hook not written with --no-daemon: %v", calls())
+		}
+	})
+
+	t.Run("reports bd's stderr", func(t *testing.T) {
+		installBDStub(t, `case "$cmd" in update) echo "Error: database locked" >&2; exit 1 ;; esac`)
+		err := New(t.TempDir()).Hook("gt-abc", "gastown/polecats/Toast")
+		if err == nil || !strings.Contains(err.Error(), "database locked") {
+			t.Errorf("Hook = %v, want bd's stderr in the error", err)
+		}
+	})
+}
+
+func TestCreateHooked(t *testing.T) {
+	agent := "gastown/polecats/Toast"
+
+	t.Run("hooks the new bead", func(t *testing.T) {
+		calls := installBDStub(t, `
+case "$cmd" in
+  create) printf '%s\n' '{"id":"gt-new","title":"Synthetic","status":"open","assignee":"gastown/polecats/Toast"}' ;;
+esac
+`)
+		issue, err := New(t.TempDir()).CreateHooked(CreateOptions{Title: "Synthetic", Priority: -1}, agent)
+		if err != nil {
+			t.Fatalf("CreateHooked: %v", err)
+		}
+		if issue.Status != StatusHooked || issue.Assignee != agent {
+			t.Errorf("got (%s, %s), want (%s, %s)", issue.Status, issue.Assignee, StatusHooked, agent)
+		}
+		if !hasCall(calls(), "create", "--assignee="+agent) {
+			t.Errorf("bead not created pre-assigned: %v", calls())
+		}
+		if !hasCall(calls(), "update gt-new", "--status=hooked") {
+			t.Errorf("bead not hooked: %v", calls())
+		}
+	})
+
+	t.Run("rolls back when hooking fails", func(t *testing.T) {
+		calls := installBDStub(t, `
+case "$cmd" in
+  create) printf '%s\n' '{"id":"gt-new","title":"Synthetic"}' ;;
+  update) echo "Error: database locked" >&2; exit 1 ;;
+esac
+`)
+		if _, err := New(t.TempDir()).CreateHooked(CreateOptions{Title: "Synthetic", Priority: -1}, agent); err == nil {
+			t.Fatal("expected error when the hook update fails")
+		}
+		if !hasCall(calls(), "delete gt-new", "--hard") {
+			t.Errorf("new bead not rolled back: %v", calls())
+		}
+	})
+}
@@ -0,0 +1,134 @@
+// Package beads provides conditional step evaluation for branching molecules.
+package beads
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ConditionPredicate is one comparison in a step condition, e.g.
+// "tests.status == closed" or "tests.label == failed".
+type ConditionPredicate struct {
+	Ref   string // Step ref or bead ID the predicate reads
+	Field string // "status" or "label"
+	Op    string // "==" or "!="
+	Value string
+}
+
+// Condition is a conjunction of predicates over bead state. Conditions are
+// deliberately limited to status and label checks so formulas can branch
+// without running arbitrary code.
+//
+// Syntax: <ref>.<field> <op> <value> [&& ...], where field is status or
+// label and op is == or !=. For labels, == means the bead has the label
+// and != means it does not.
+type Condition struct {
+	Predicates []ConditionPredicate
+}
+
+// ParseCondition parses a step condition expression.
+func ParseCondition(expr string) (*Condition, error) {
+	expr = strings.TrimSpace(expr)
+	if expr == "" {
+		return nil, fmt.Errorf("empty condition")
+	}
+
+	cond := &Condition{}
+	for _, part := range strings.Split(expr, "&&") {
+		fields := strings.Fields(part)
+		if len(fields) != 3 {
+			return nil, fmt.Errorf("invalid predicate %q: want <ref>.<field> <op> <value>", strings.TrimSpace(part))
+		}
+		dot := strings.LastIndex(fields[0], ".")
+		if dot <= 0 || dot == len(fields[0])-1 {
+			return nil, fmt.Errorf("invalid predicate %q: want <ref>.<field>", fields[0])
+		}
+		p := ConditionPredicate{
+			Ref:   fields[0][:dot],
+			Field: fields[0][dot+1:],
+			Op:    fields[1],
+			Value: fields[2],
+		}
+		if p.Field != "status" && p.Field != "label" {
+			return nil, fmt.Errorf("unknown condition field %q (want status or label)", p.Field)
+		}
+		if p.Op != "==" && p.Op != "!=" {
+			return nil, fmt.Errorf("unknown condition operator %q (want == or !=)", p.Op)
+		}
+		cond.Predicates = append(cond.Predicates, p)
+	}
+	return cond, nil
+}
+
+// Refs returns the refs the condition reads, in order of appearance.
+func (c *Condition) Refs() []string {
+	refs := make([]string, 0, len(c.Predicates))
+	for _, p := range c.Predicates {
+		refs = append(refs, p.Ref)
+	}
+	return refs
+}
+
+// Eval reports whether every predicate holds. resolve maps a ref to the
+// bead it names; an unresolvable ref is an error.
+func (c *Condition) Eval(resolve func(ref string) (*Issue, error)) (bool, error) {
+	for _, p := range c.Predicates {
+		issue, err := resolve(p.Ref)
+		if err != nil {
+			return false, fmt.Errorf("resolving %s: %w", p.Ref, err)
+		}
+
+		var match bool
+		switch p.Field {
+		case "status":
+			match = issue.Status == p.Value
+		case "label":
+			for _, l := range issue.Labels {
+				if l == p.Value {
+					match = true
+					break
+				}
+			}
+		}
+		if match != (p.Op == "==") {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// stepCondition returns the condition recorded on an instantiated step
+// ("condition: <expr>" in its provenance block), or "" if the step is
+// unconditional.
+func stepCondition(step *Issue) string {
+	return provenanceLine(step.Description, "condition")
+}
+
+// provenanceLine returns the value of a "<key>: <value>" line from the
+// provenance block that InstantiateMolecule appends to a step description:
+// the lines from the last "instantiated_from:" line up to the next blank
+// line. Lines in the step's instructions are never read, so prose that
+// happens to start with "condition:" is not mistaken for a condition.
+func provenanceLine(description, key string) string {
+	lines := strings.Split(description, "\n")
+	start := -1
+	for i, line := range lines {
+		if strings.HasPrefix(strings.TrimSpace(line), "instantiated_from:") {
+			start = i
+		}
+	}
+	if start < 0 {
+		return ""
+	}
+	for _, line := range lines[start:] {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			break
+		}
+		k, v, ok := strings.Cut(line, ":")
+		if ok && strings.EqualFold(strings.TrimSpace(k), key) {
+			return strings.TrimSpace(v)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,47 @@
+// Package beads provides typed reads of bd config keys.
+package beads
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// GetConfigOr returns the value of a bd config key, or def if the key is
+// unset or cannot be read.
+func (b *Beads) GetConfigOr(key, def string) string {
+	value, err := b.GetConfig(key)
+	if err != nil || value == "" {
+		return def
+	}
+	return value
+}
+
+// GetConfigInt returns a bd config key parsed as an integer. An unset key
+// yields def with no error; a read failure or malformed value yields def
+// and the error.
+func (b *Beads) GetConfigInt(key string, def int) (int, error) {
+	value, err := b.GetConfig(key)
+	if err != nil || value == "" {
+		return def, err
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return def, fmt.Errorf("config %s: %q is not an integer", key, value)
+	}
+	return n, nil
+}
+
+// GetConfigBool returns a bd config key parsed as a boolean (any form
+// strconv.ParseBool accepts). An unset key yields def with no error; a read
+// failure or malformed value yields def and the error.
+func (b *Beads) GetConfigBool(key string, def bool) (bool, error) {
+	value, err := b.GetConfig(key)
+	if err != nil || value == "" {
+		return def, err
+	}
+	v, err := strconv.ParseBool(value)
+	if err != nil {
+		return def, fmt.Errorf("config %s: %q is not a boolean", key, value)
+	}
+	return v, nil
+}
@@ -0,0 +1,73 @@
+package beads
+
+import "testing"
+
+// configStub answers bd config get from a fixed set of keys.
+const configStub = `
+case "$cmd $1" in
+  "config get")
+    case "$2" in
+      limit) echo "42" ;;
+      enabled) echo "true" ;;
+      name) echo "gastown" ;;
+      garbled) echo "lots" ;;
+      *) echo "$2 (not set)" ;;
+    esac
+    ;;
+esac
+`
+
+func TestGetConfigOr(t *testing.T) {
+	installBDStub(t, configStub)
+	b := New(t.TempDir())
+
+	if got := b.GetConfigOr("name", "default"); got != "gastown" {
+		t.Errorf("present: got %q, want gastown", got)
+	}
+	if got := b.GetConfigOr("missing", "default"); got != "default" {
+		t.Errorf("missing: got %q, want default", got)
+	}
+}
+
+func TestGetConfigInt(t *testing.T) {
+	installBDStub(t, configStub)
+	b := New(t.TempDir())
+
+	tests := []struct {
+		key     string
+		want    int
+		wantErr bool
+	}{
+		{"limit", 42, false},
+		{"missing", 7, false},
+		{"garbled", 7, true},
+	}
+	for _, tt := range tests {
+		got, err := b.GetConfigInt(tt.key, 7)
+		if got != tt.want || (err != nil) != tt.wantErr {
+			t.Errorf("GetConfigInt(%s) = %d, %v; want %d, err=%v", tt.key, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestGetConfigBool(t *testing.T) {
+	installBDStub(t, configStub)
+	b := New(t.TempDir())
+
+	tests := []struct {
+		key     string
+		def     bool
+		want    bool
+		wantErr bool
+	}{
+		{"enabled", false, true, false},
+		{"missing", true, true, false},
+		{"garbled", true, true, true},
+	}
+	for _, tt := range tests {
+		got, err := b.GetConfigBool(tt.key, tt.def)
+		if got != tt.want || (err != nil) != tt.wantErr {
+			t.Errorf("GetConfigBool(%s) = %v, %v; want %v, err=%v", tt.key, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+// Package beads provides idempotent auto-convoy creation.
+package beads
+
+import (
+	"crypto/sha256"
+	"encoding/base32"
+	"fmt"
+	"strings"
+)
+
+// AutoConvoyID returns the deterministic ID of the auto-convoy that tracks
+// beadID. Deriving the ID from the bead lets concurrent slings of the same
+// bead collide on create instead of making two convoys.
+func AutoConvoyID(beadID string) string {
+	sum := sha256.Sum256([]byte(beadID))
+	return "hq-cv-" + strings.ToLower(base32.StdEncoding.EncodeToString(sum[:])[:8])
+}
+
+// EnsureAutoConvoy creates the auto-convoy for beadID if it does not exist
+// yet and adds its tracking relation to trackID (see DepTypeTracks).
+// Returns the convoy ID and whether this call created it. Callers racing on
+// the same bead converge on one convoy: the loser's create fails with a
+// UNIQUE constraint error and it reuses the winner's convoy, adding the
+// tracking relation if the convoy does not have it (the winner may have
+// failed before adding it).
+//
+// A failure to add the tracking relation is returned alongside the convoy
+// ID, since the convoy itself exists.
+func (b *Beads) EnsureAutoConvoy(beadID, title, trackID string) (string, bool, error) {
+	convoyID := AutoConvoyID(beadID)
+
+	args := []string{
+		"create",
+		"--type=convoy",
+		"--id=" + convoyID,
+		"--title=Work: " + title,
+		"--description=Auto-created convoy tracking " + beadID,
+	}
+	if NeedsForceForID(convoyID) {
+		args = append(args, "--force")
+	}
+
+	if _, err := b.run(args...); err != nil {
+		if strings.Contains(err.Error(), "UNIQUE constraint failed") {
+			return convoyID, false, b.ensureTracks(convoyID, trackID)
+		}
+		return "", false, fmt.Errorf("creating convoy: %w", err)
+	}
+
+	return convoyID, true, b.addTracks(convoyID, trackID)
+}
+
+// ensureTracks adds convoyID's tracking relation to trackID unless the
+// convoy already has it.
+func (b *Beads) ensureTracks(convoyID, trackID string) error {
+	if ok, err := b.convoyTracks(convoyID, trackID); err != nil || ok {
+		return err
+	}
+	return b.addTracks(convoyID, trackID)
+}
+
+// addTracks adds convoyID's tracking relation to trackID. An add that fails
+// because a concurrent caller added the relation first is not an error.
+func (b *Beads) addTracks(convoyID, trackID string) error {
+	if err := b.AddTypedDependency(convoyID, trackID, DepTypeTracks); err != nil {
+		if ok, _ := b.convoyTracks(convoyID, trackID); ok {
+			return nil
+		}
+		return fmt.Errorf("adding tracking relation: %w", err)
+	}
+	return nil
+}
+
+// convoyTracks reports whether convoyID already depends on trackID.
+func (b *Beads) convoyTracks(convoyID, trackID string) (bool, error) {
+	convoy, err := b.Show(convoyID)
+	if err != nil {
+		return false, fmt.Errorf("reading convoy %s: %w", convoyID, err)
+	}
+	for _, dep := range convoy.Dependencies {
+		if dep.ID == trackID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
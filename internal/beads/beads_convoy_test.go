@@ -0,0 +1,136 @@
+package beads
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestAutoConvoyIDDeterministic(t *testing.T) {
+	a, b := AutoConvoyID("gt-abc"), AutoConvoyID("gt-abc")
+	if a != b {
+		t.Errorf("AutoConvoyID not deterministic: %q vs %q", a, b)
+	}
+	if a == AutoConvoyID("gt-abd") {
+		t.Errorf("different beads share convoy ID %q", a)
+	}
+}
+
+func TestEnsureAutoConvoyConcurrent(t *testing.T) {
+	stateDir := t.TempDir()
+	installConvoyStub(t, stateDir)
+
+	b := New(t.TempDir())
+	const racers = 2
+	var wg sync.WaitGroup
+	ids := make([]string, racers)
+	created := make([]bool, racers)
+	errs := make([]error, racers)
+	for i := 0; i < racers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			ids[i], created[i], errs[i] = b.EnsureAutoConvoy("gt-abc", "Fix it", "external:gt-abc:gt-abc")
+		}(i)
+	}
+	wg.Wait()
+
+	createdCount := 0
+	for i := 0; i < racers; i++ {
+		if errs[i] != nil {
+			t.Fatalf("racer %d: %v", i, errs[i])
+		}
+		if ids[i] != AutoConvoyID("gt-abc") {
+			t.Errorf("racer %d got convoy %q, want %q", i, ids[i], AutoConvoyID("gt-abc"))
+		}
+		if created[i] {
+			createdCount++
+		}
+	}
+	if createdCount != 1 {
+		t.Errorf("%d racers created the convoy, want exactly 1", createdCount)
+	}
+
+	entries, err := os.ReadDir(stateDir)
+	if err != nil || len(entries) != 1 {
+		t.Fatalf("convoys on disk = %d (err %v), want 1", len(entries), err)
+	}
+	if _, err := os.Stat(filepath.Join(stateDir, AutoConvoyID("gt-abc"))); err != nil {
+		t.Errorf("surviving convoy has unexpected ID: %v", err)
+	}
+
+	if got := convoyTracks(t, stateDir, AutoConvoyID("gt-abc")); got != "external:gt-abc:gt-abc" {
+		t.Errorf("tracking relations = %q, want exactly one to external:gt-abc:gt-abc", got)
+	}
+}
+
+func TestEnsureAutoConvoyRepairsMissingTracks(t *testing.T) {
+	// The convoy exists but its creator failed before adding the relation
+	stateDir := t.TempDir()
+	installConvoyStub(t, stateDir)
+	convoyID := AutoConvoyID("gt-abc")
+	if err := os.Mkdir(filepath.Join(stateDir, convoyID), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	id, created, err := New(t.TempDir()).EnsureAutoConvoy("gt-abc", "Fix it", "gt-abc")
+	if err != nil || id != convoyID || created {
+		t.Fatalf("EnsureAutoConvoy = %q, %v, %v; want existing %s", id, created, err, convoyID)
+	}
+	if got := convoyTracks(t, stateDir, convoyID); got != "gt-abc" {
+		t.Errorf("tracking relations = %q, want gt-abc", got)
+	}
+
+	// Already tracked: nothing is added twice
+	if _, _, err := New(t.TempDir()).EnsureAutoConvoy("gt-abc", "Fix it", "gt-abc"); err != nil {
+		t.Fatalf("EnsureAutoConvoy: %v", err)
+	}
+	if got := convoyTracks(t, stateDir, convoyID); got != "gt-abc" {
+		t.Errorf("tracking relations = %q, want gt-abc once", got)
+	}
+}
+
+// installConvoyStub serves convoys from stateDir: create makes a directory
+// per ID (mkdir is atomic, so it behaves like a UNIQUE id column), dep add
+// makes <id>/dep-<track> (refusing duplicates the same way), and show lists
+// them.
+func installConvoyStub(t *testing.T, stateDir string) {
+	t.Helper()
+	installBDStub(t, `STATE="`+stateDir+`"
+case "$cmd" in
+  create)
+    for arg in "$@"; do
+      case "$arg" in --id=*) id="${arg#--id=}" ;; esac
+    done
+    mkdir "$STATE/$id" 2>/dev/null || { echo "UNIQUE constraint failed: issues.id" >&2; exit 1; }
+    printf '%s\n' "$id"
+    ;;
+  dep)
+    mkdir "$STATE/$2/dep-$3" 2>/dev/null || { echo "UNIQUE constraint failed: dependencies" >&2; exit 1; }
+    printf '%s\n' '{"status":"added"}'
+    ;;
+  show)
+    deps=""
+    for d in "$STATE/$1"/dep-*; do
+      [ -d "$d" ] && deps="$deps{\"id\":\"${d##*/dep-}\",\"dependency_type\":\"tracks\"},"
+    done
+    printf '[{"id":"%s","dependencies":[%s]}]\n' "$1" "${deps%,}"
+    ;;
+esac`)
+}
+
+// convoyTracks returns the tracking relations recorded for convoyID.
+func convoyTracks(t *testing.T, stateDir, convoyID string) string {
+	t.Helper()
+	matches, err := filepath.Glob(filepath.Join(stateDir, convoyID, "dep-*"))
+	if err != nil {
+		t.Fatalf("reading tracks: %v", err)
+	}
+	var tracks []string
+	for _, m := range matches {
+		tracks = append(tracks, strings.TrimPrefix(filepath.Base(m), "dep-"))
+	}
+	return strings.Join(tracks, "\n")
+}
@@ -0,0 +1,109 @@
+// Package beads provides duplicate-title detection for open beads.
+package beads
+
+import (
+	"strings"
+	"unicode"
+)
+
+// DefaultDuplicateThreshold is the title similarity at or above which two
+// beads are reported as duplicates when DuplicateOptions.Threshold is zero.
+const DefaultDuplicateThreshold = 0.8
+
+// DuplicateOptions specifies options for FindDuplicates.
+type DuplicateOptions struct {
+	// Threshold is the minimum title similarity (0-1) for two beads to be
+	// clustered. 1 matches only titles identical after normalization.
+	// Zero uses DefaultDuplicateThreshold.
+	Threshold float64
+	Label     string // Only consider beads with this label (e.g., "gt:task")
+}
+
+// FindDuplicates groups open beads whose titles are identical or similar
+// enough to likely describe the same work. Similarity is the overlap of
+// the titles' word sets after lowercasing and stripping punctuation, and
+// clusters are transitive: if A~B and B~C, all three are grouped. Each
+// cluster has at least two beads, in bd list order.
+func (b *Beads) FindDuplicates(opts DuplicateOptions) ([][]*Issue, error) {
+	issues, err := b.List(ListOptions{Status: "open", Label: opts.Label, Priority: -1})
+	if err != nil {
+		return nil, err
+	}
+	threshold := opts.Threshold
+	if threshold <= 0 {
+		threshold = DefaultDuplicateThreshold
+	}
+	return clusterDuplicates(issues, threshold), nil
+}
+
+// clusterDuplicates unions every pair of issues whose titles meet threshold.
+func clusterDuplicates(issues []*Issue, threshold float64) [][]*Issue {
+	tokens := make([]map[string]bool, len(issues))
+	for i, issue := range issues {
+		tokens[i] = titleTokens(issue.Title)
+	}
+
+	parent := make([]int, len(issues))
+	for i := range parent {
+		parent[i] = i
+	}
+	var find func(int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+	for i := range issues {
+		for j := i + 1; j < len(issues); j++ {
+			if titleSimilarity(tokens[i], tokens[j]) >= threshold {
+				parent[find(j)] = find(i)
+			}
+		}
+	}
+
+	groups := make(map[int][]*Issue)
+	var roots []int
+	for i, issue := range issues {
+		root := find(i)
+		if groups[root] == nil {
+			roots = append(roots, root)
+		}
+		groups[root] = append(groups[root], issue)
+	}
+	var clusters [][]*Issue
+	for _, root := range roots {
+		if len(groups[root]) > 1 {
+			clusters = append(clusters, groups[root])
+		}
+	}
+	return clusters
+}
+
+// titleTokens returns the set of lowercased words in a title, ignoring
+// punctuation so "Fix: widget" and "fix widget" normalize alike.
+func titleTokens(title string) map[string]bool {
+	words := strings.FieldsFunc(strings.ToLower(title), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	set := make(map[string]bool, len(words))
+	for _, w := range words {
+		set[w] = true
+	}
+	return set
+}
+
+// titleSimilarity is the Jaccard overlap of two word sets: shared words
+// over total distinct words. Two empty titles are not considered similar.
+func titleSimilarity(a, b map[string]bool) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+	shared := 0
+	for w := range a {
+		if b[w] {
+			shared++
+		}
+	}
+	return float64(shared) / float64(len(a)+len(b)-shared)
+}
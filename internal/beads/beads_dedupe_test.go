@@ -0,0 +1,68 @@
+package beads
+
+import (
+	"strings"
+	"testing"
+)
+
+func clusterIDs(clusters [][]*Issue) []string {
+	var out []string
+	for _, c := range clusters {
+		var ids []string
+		for _, issue := range c {
+			ids = append(ids, issue.ID)
+		}
+		out = append(out, strings.Join(ids, "+"))
+	}
+	return out
+}
+
+func TestFindDuplicates(t *testing.T) {
+	calls := installBDStub(t, `
+case "$cmd" in
+  list)
+    printf '%s\n' '[{"id":"gt-1","title":"Fix widget crash"},{"id":"gt-2","title":"Add login page"},{"id":"gt-3","title":"fix: Widget crash!"},{"id":"gt-4","title":"Add login page styles"}]'
+    ;;
+esac
+`)
+	b := New(t.TempDir())
+
+	tests := []struct {
+		name      string
+		threshold float64
+		want      string
+	}{
+		// "Add login page" vs "Add login page styles" share 3 of 4 words (0.75)
+		{"exact only", 1, "gt-1+gt-3"},
+		{"default excludes near-duplicate below threshold", 0, "gt-1+gt-3"},
+		{"near-duplicate crosses lower threshold", 0.7, "gt-1+gt-3,gt-2+gt-4"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clusters, err := b.FindDuplicates(DuplicateOptions{Threshold: tt.threshold})
+			if err != nil {
+				t.Fatalf("FindDuplicates: %v", err)
+			}
+			if got := strings.Join(clusterIDs(clusters), ","); got != tt.want {
+				t.Errorf("clusters = %s, want %s", got, tt.want)
+			}
+		})
+	}
+	if !hasCall(calls(), "list", "--status=open") {
+		t.Errorf("expected open beads to be listed, got %v", calls())
+	}
+}
+
+func TestClusterDuplicatesTransitive(t *testing.T) {
+	issues := []*Issue{
+		{ID: "gt-a", Title: "refinery merge queue stalls"},
+		{ID: "gt-b", Title: "refinery merge queue stalls often"},
+		{ID: "gt-c", Title: "merge queue stalls often"},
+		{ID: "gt-d", Title: ""},
+		{ID: "gt-e", Title: ""},
+	}
+	got := strings.Join(clusterIDs(clusterDuplicates(issues, 0.75)), ",")
+	if got != "gt-a+gt-b+gt-c" {
+		t.Errorf("clusters = %s, want gt-a+gt-b+gt-c (empty titles never match)", got)
+	}
+}
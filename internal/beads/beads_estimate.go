@@ -0,0 +1,41 @@
+package beads
+
+import "sort"
+
+// ReadyWithinBudget returns ready beads whose combined estimate fits within
+// budget minutes, for dispatchers pulling "about a day's worth" of work.
+// Beads are taken greedily by priority (P0 first, ready order breaking ties);
+// a bead too large for what is left is skipped so smaller ones behind it can
+// still fill the budget. Unestimated beads are left out, since their cost is
+// unknown.
+func (b *Beads) ReadyWithinBudget(budget int) ([]*Issue, error) {
+	ready, err := b.Ready()
+	if err != nil {
+		return nil, err
+	}
+	return packBudget(ready, budget), nil
+}
+
+// packBudget greedily selects estimated issues by priority until budget is
+// spent.
+func packBudget(issues []*Issue, budget int) []*Issue {
+	candidates := make([]*Issue, 0, len(issues))
+	for _, issue := range issues {
+		if issue.Estimate > 0 {
+			candidates = append(candidates, issue)
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].Priority < candidates[j].Priority
+	})
+
+	var picked []*Issue
+	remaining := budget
+	for _, issue := range candidates {
+		if issue.Estimate <= remaining {
+			picked = append(picked, issue)
+			remaining -= issue.Estimate
+		}
+	}
+	return picked
+}
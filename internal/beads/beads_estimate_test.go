@@ -0,0 +1,75 @@
+package beads
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestReadyWithinBudget(t *testing.T) {
+	installBDStub(t, `
+case "$cmd" in
+  ready)
+    cat <<'JSON'
+[
+ {"id":"gt-big","priority":1,"estimated_minutes":300},
+ {"id":"gt-urgent","priority":0,"estimated_minutes":120},
+ {"id":"gt-unknown","priority":0},
+ {"id":"gt-small","priority":2,"estimated_minutes":30},
+ {"id":"gt-medium","priority":2,"estimated_minutes":240},
+ {"id":"gt-tiny","priority":3,"estimated_minutes":15}
+]
+JSON
+    ;;
+esac
+`)
+	b := New(t.TempDir())
+
+	tests := []struct {
+		budget int
+		want   []string
+	}{
+		// P0 first, then P1 fills most of the day; gt-medium no longer fits
+		// but the smaller beads behind it do.
+		{480, []string{"gt-urgent", "gt-big", "gt-small", "gt-tiny"}},
+		// gt-big is skipped, leaving room for gt-medium.
+		{400, []string{"gt-urgent", "gt-small", "gt-medium"}},
+		{60, []string{"gt-small", "gt-tiny"}},
+		{10, []string{}},
+	}
+	for _, tt := range tests {
+		got, err := b.ReadyWithinBudget(tt.budget)
+		if err != nil {
+			t.Fatalf("ReadyWithinBudget(%d): %v", tt.budget, err)
+		}
+		if ids := issueIDs(got); !reflect.DeepEqual(ids, tt.want) {
+			t.Errorf("ReadyWithinBudget(%d) = %v, want %v", tt.budget, ids, tt.want)
+		}
+	}
+}
+
+func TestCreateEstimate(t *testing.T) {
+	calls := installBDStub(t, `
+case "$cmd" in
+  create) printf '%s\n' '{"id":"gt-new","title":"New","estimated_minutes":90}' ;;
+esac
+`)
+	b := New(t.TempDir())
+
+	issue, err := b.Create(CreateOptions{Title: "New", Priority: -1, Estimate: 90})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if !hasCall(calls(), "create", "--estimate=90") {
+		t.Errorf("estimate not passed to bd create: %v", calls())
+	}
+	if issue.Estimate != 90 {
+		t.Errorf("Estimate = %d, want 90", issue.Estimate)
+	}
+
+	if _, err := b.Create(CreateOptions{Title: "Unestimated", Priority: -1}); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if hasCall(calls(), "create", "--title=Unestimated", "--estimate") {
+		t.Errorf("unexpected --estimate for unestimated bead: %v", calls())
+	}
+}
@@ -0,0 +1,42 @@
+// Package beads provides a raw bd runner for callers that rely on bd's own routing.
+package beads
+
+import (
+	"bytes"
+	"errors"
+	"os/exec"
+)
+
+// RunBD runs bd with args in dir and returns stdout.
+//
+// Unlike Beads.Run, it does not set BEADS_DIR or add --allow-stale, so bd's
+// own routes.jsonl prefix routing applies. Errors are wrapped like Beads
+// errors, including those hidden by the --no-daemon exit-0 bug.
+func RunBD(dir string, args ...string) ([]byte, error) {
+	cmd := exec.Command("bd", args...) //nolint:gosec // G204: bd is a trusted internal tool
+	cmd.Dir = dir
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return nil, wrapBDError(err, stderr.String(), args)
+	}
+	if err := checkNoDaemonOutput(stdout.Bytes(), stderr.Bytes(), args); err != nil {
+		return nil, err
+	}
+	return stdout.Bytes(), nil
+}
+
+// checkNoDaemonOutput detects the bd --no-daemon exit code 0 bug: on a
+// failure such as an issue not being found, bd exits 0 but writes the error
+// to stderr and nothing to stdout. The stderr is wrapped as if bd had
+// failed, so only a not-found message becomes ErrNotFound. This is the one
+// place that workaround lives; remove it once bd exits non-zero.
+func checkNoDaemonOutput(stdout, stderr []byte, args []string) error {
+	if len(stdout) == 0 && len(bytes.TrimSpace(stderr)) > 0 {
+		return wrapBDError(errors.New("command produced no output"), string(stderr), args)
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package beads
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestRunBDNoDaemonEmptyStdout(t *testing.T) {
+	// Simulate the bd --no-daemon exit-0 bug: not-found error on stderr,
+	// nothing on stdout, exit status 0.
+	installBDStub(t, `case "$1" in
+  gt-missing) echo "Error: issue gt-missing not found" >&2 ;;
+  gt-locked) echo "Error: database is locked" >&2 ;;
+  *) printf '%s\n' '[{"id":"'"$1"'"}]' ;;
+esac`)
+
+	if _, err := RunBD(t.TempDir(), "--no-daemon", "show", "gt-missing", "--json"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("RunBD(missing) error = %v, want ErrNotFound", err)
+	}
+
+	// Any other failure keeps its message and is not "not found".
+	_, err := RunBD(t.TempDir(), "--no-daemon", "show", "gt-locked", "--json")
+	if err == nil || errors.Is(err, ErrNotFound) || !strings.Contains(err.Error(), "database is locked") {
+		t.Errorf("RunBD(locked) error = %v, want the bd error", err)
+	}
+
+	out, err := RunBD(t.TempDir(), "--no-daemon", "show", "gt-abc", "--json")
+	if err != nil || len(out) == 0 {
+		t.Errorf("RunBD(existing) = (%q, %v), want output", out, err)
+	}
+
+	// Beads.run shares the same detection.
+	if _, err := New(t.TempDir()).Show("gt-missing"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Show(missing) error = %v, want ErrNotFound", err)
+	}
+}
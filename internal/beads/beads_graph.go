@@ -0,0 +1,185 @@
+// Package beads provides dependency graph export for visualizing epics.
+package beads
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// GraphNode is an issue in a dependency graph.
+type GraphNode struct {
+	ID     string `json:"id"`
+	Title  string `json:"title"`
+	Status string `json:"status"`
+}
+
+// GraphEdge records that From depends on To with the given dependency type.
+// Back is set on edges that close a cycle.
+type GraphEdge struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+	Type string `json:"type,omitempty"`
+	Back bool   `json:"back,omitempty"`
+}
+
+// Graph is the dependency network around a root issue.
+type Graph struct {
+	Root  string      `json:"root"`
+	Nodes []GraphNode `json:"nodes"`
+	Edges []GraphEdge `json:"edges"`
+}
+
+// DependencyGraph returns the issues reachable from rootID through
+// dependencies and dependents, up to depth hops (negative for unlimited).
+// Each level is fetched with a single bd show call. Cycles are returned
+// with their closing edges marked Back.
+func (b *Beads) DependencyGraph(rootID string, depth int) (*Graph, error) {
+	g := &Graph{Root: rootID}
+	seen := map[string]bool{rootID: true}
+	edgeSeen := make(map[GraphEdge]bool)
+	addEdge := func(e GraphEdge) {
+		if !edgeSeen[e] {
+			edgeSeen[e] = true
+			g.Edges = append(g.Edges, e)
+		}
+	}
+
+	frontier := []string{rootID}
+	for level := 0; len(frontier) > 0; level++ {
+		issues, err := b.ShowMultiple(frontier)
+		if err != nil {
+			return nil, err
+		}
+		if level == 0 && issues[rootID] == nil {
+			return nil, ErrNotFound
+		}
+
+		var next []string
+		for _, id := range frontier {
+			issue := issues[id]
+			if issue == nil {
+				g.Nodes = append(g.Nodes, GraphNode{ID: id})
+				continue
+			}
+			g.Nodes = append(g.Nodes, GraphNode{ID: id, Title: issue.Title, Status: issue.Status})
+			if depth >= 0 && level >= depth {
+				continue
+			}
+
+			for _, dep := range issue.Dependencies {
+				addEdge(GraphEdge{From: id, To: dep.ID, Type: dep.DependencyType})
+				if !seen[dep.ID] {
+					seen[dep.ID] = true
+					next = append(next, dep.ID)
+				}
+			}
+			for _, dep := range issue.Dependents {
+				addEdge(GraphEdge{From: dep.ID, To: id, Type: dep.DependencyType})
+				if !seen[dep.ID] {
+					seen[dep.ID] = true
+					next = append(next, dep.ID)
+				}
+			}
+		}
+		frontier = next
+	}
+
+	// Edges to nodes beyond the depth limit are dropped
+	kept := g.Edges[:0]
+	for _, e := range g.Edges {
+		if seen[e.From] && seen[e.To] {
+			kept = append(kept, e)
+		}
+	}
+	g.Edges = kept
+
+	g.markBackEdges()
+	return g, nil
+}
+
+// markBackEdges flags edges that close a cycle, found by depth-first search
+// over the directed dependency edges.
+func (g *Graph) markBackEdges() {
+	out := make(map[string][]int)
+	for i, e := range g.Edges {
+		out[e.From] = append(out[e.From], i)
+	}
+
+	const (
+		unvisited = iota
+		onStack
+		done
+	)
+	state := make(map[string]int)
+	var visit func(id string)
+	visit = func(id string) {
+		state[id] = onStack
+		for _, i := range out[id] {
+			switch state[g.Edges[i].To] {
+			case onStack:
+				g.Edges[i].Back = true
+			case unvisited:
+				visit(g.Edges[i].To)
+			}
+		}
+		state[id] = done
+	}
+
+	ids := make([]string, 0, len(g.Nodes))
+	for _, n := range g.Nodes {
+		ids = append(ids, n.ID)
+	}
+	sort.Strings(ids)
+	// Start from the root so cycle edges are marked relative to it
+	ids = append([]string{g.Root}, ids...)
+	for _, id := range ids {
+		if state[id] == unvisited {
+			visit(id)
+		}
+	}
+}
+
+// WriteDOT renders the graph in Graphviz DOT format. Closed issues are
+// greyed out and back edges are drawn dashed in red.
+func (g *Graph) WriteDOT(w io.Writer) error {
+	if _, err := fmt.Fprintf(w, "digraph \"%s\" {\n  rankdir=LR;\n", dotEscape(g.Root)); err != nil {
+		return err
+	}
+	for _, n := range g.Nodes {
+		attrs := ""
+		if n.Status == "closed" {
+			attrs = ", color=gray, fontcolor=gray"
+		}
+		if n.ID == g.Root {
+			attrs += ", penwidth=2"
+		}
+		label := dotEscape(n.ID)
+		if n.Title != "" {
+			label += `\n` + dotEscape(n.Title)
+		}
+		if _, err := fmt.Fprintf(w, "  \"%s\" [label=\"%s\"%s];\n", dotEscape(n.ID), label, attrs); err != nil {
+			return err
+		}
+	}
+	for _, e := range g.Edges {
+		attrs := ""
+		if e.Type != "" && e.Type != DepTypeBlocks {
+			attrs = fmt.Sprintf(" [label=%q]", e.Type)
+		}
+		if e.Back {
+			attrs = " [style=dashed, color=red]"
+		}
+		if _, err := fmt.Fprintf(w, "  \"%s\" -> \"%s\"%s;\n", dotEscape(e.From), dotEscape(e.To), attrs); err != nil {
+			return err
+		}
+	}
+	_, err := fmt.Fprintln(w, "}")
+	return err
+}
+
+// dotEscape escapes s for use inside a double-quoted DOT string.
+func dotEscape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", " ").Replace(s)
+}
@@ -0,0 +1,98 @@
+package beads
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+// diamondStub serves a diamond: A depends on B and C, which both depend on D.
+// D also depends on A when $CYCLE is set.
+const diamondStub = `
+case "$cmd" in
+  show)
+    out=""
+    for id in "$@"; do
+      case "$id" in
+        gt-a) obj='{"id":"gt-a","title":"Epic","status":"open","dependencies":[{"id":"gt-b","dependency_type":"blocks"},{"id":"gt-c","dependency_type":"blocks"}]}' ;;
+        gt-b) obj='{"id":"gt-b","title":"B","status":"open","dependencies":[{"id":"gt-d","dependency_type":"blocks"}],"dependents":[{"id":"gt-a","dependency_type":"blocks"}]}' ;;
+        gt-c) obj='{"id":"gt-c","title":"C","status":"closed","dependencies":[{"id":"gt-d","dependency_type":"blocks"}],"dependents":[{"id":"gt-a","dependency_type":"blocks"}]}' ;;
+        gt-d)
+          if [ -n "$CYCLE" ]; then
+            obj='{"id":"gt-d","title":"D","status":"open","dependencies":[{"id":"gt-a","dependency_type":"blocks"}],"dependents":[{"id":"gt-b","dependency_type":"blocks"},{"id":"gt-c","dependency_type":"blocks"}]}'
+          else
+            obj='{"id":"gt-d","title":"D","status":"open","dependents":[{"id":"gt-b","dependency_type":"blocks"},{"id":"gt-c","dependency_type":"blocks"}]}'
+          fi ;;
+        *) continue ;;
+      esac
+      out="${out:+$out,}$obj"
+    done
+    printf '[%s]\n' "$out"
+    ;;
+esac
+`
+
+func TestDependencyGraphDiamond(t *testing.T) {
+	installBDStub(t, diamondStub)
+
+	g, err := New(t.TempDir()).DependencyGraph("gt-a", -1)
+	if err != nil {
+		t.Fatalf("DependencyGraph: %v", err)
+	}
+	if len(g.Nodes) != 4 {
+		t.Errorf("got %d nodes, want 4: %+v", len(g.Nodes), g.Nodes)
+	}
+	if len(g.Edges) != 4 {
+		t.Errorf("got %d edges, want 4: %+v", len(g.Edges), g.Edges)
+	}
+	for _, e := range g.Edges {
+		if e.Back {
+			t.Errorf("acyclic diamond has back edge %+v", e)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := g.WriteDOT(&buf); err != nil {
+		t.Fatalf("WriteDOT: %v", err)
+	}
+	dot := buf.String()
+	for _, want := range []string{`digraph "gt-a"`, `"gt-b" -> "gt-d";`, `[label="gt-a\nEpic", penwidth=2]`} {
+		if !strings.Contains(dot, want) {
+			t.Errorf("DOT output missing %q:\n%s", want, dot)
+		}
+	}
+}
+
+func TestDependencyGraphDepth(t *testing.T) {
+	installBDStub(t, diamondStub)
+
+	g, err := New(t.TempDir()).DependencyGraph("gt-a", 1)
+	if err != nil {
+		t.Fatalf("DependencyGraph: %v", err)
+	}
+	if len(g.Nodes) != 3 || len(g.Edges) != 2 {
+		t.Errorf("depth 1: got %d nodes, %d edges, want 3 and 2", len(g.Nodes), len(g.Edges))
+	}
+}
+
+func TestDependencyGraphCycle(t *testing.T) {
+	installBDStub(t, diamondStub)
+	t.Setenv("CYCLE", "1")
+
+	g, err := New(t.TempDir()).DependencyGraph("gt-a", -1)
+	if err != nil {
+		t.Fatalf("DependencyGraph: %v", err)
+	}
+	if len(g.Edges) != 5 {
+		t.Fatalf("got %d edges, want 5", len(g.Edges))
+	}
+	var back []GraphEdge
+	for _, e := range g.Edges {
+		if e.Back {
+			back = append(back, e)
+		}
+	}
+	if len(back) != 1 || back[0].From != "gt-d" || back[0].To != "gt-a" {
+		t.Errorf("back edges = %+v, want only gt-d -> gt-a", back)
+	}
+}
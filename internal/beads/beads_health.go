@@ -0,0 +1,142 @@
+// Package beads provides an aggregated health summary for a beads repository.
+package beads
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// HealthLevel is a traffic-light health rating.
+type HealthLevel string
+
+const (
+	HealthGreen  HealthLevel = "green"
+	HealthYellow HealthLevel = "yellow"
+	HealthRed    HealthLevel = "red"
+)
+
+// healthRank orders levels so the worst can be picked.
+var healthRank = map[HealthLevel]int{HealthGreen: 0, HealthYellow: 1, HealthRed: 2}
+
+// healthCheckTimeout bounds each sub-check of HealthSummary.
+const healthCheckTimeout = 5 * time.Second
+
+// HealthCheck is the result of one sub-check in a HealthSummary.
+type HealthCheck struct {
+	Name    string      `json:"name"`
+	Level   HealthLevel `json:"level"`
+	Message string      `json:"message"`
+}
+
+// HealthSummary combines daemon, sync, and repository health.
+// Overall is the worst level among Checks.
+type HealthSummary struct {
+	Overall HealthLevel   `json:"overall"`
+	Checks  []HealthCheck `json:"checks"`
+}
+
+// healthProbe is a named sub-check run by summarizeHealth. run must return
+// promptly once ctx is done, killing any bd process it started.
+type healthProbe struct {
+	name string
+	run  func(ctx context.Context) HealthCheck
+}
+
+// HealthSummary reports overall beads health for the working directory.
+// Sub-checks run concurrently, each bounded by a timeout, so one slow bd
+// call degrades the summary to yellow instead of blocking it.
+func (b *Beads) HealthSummary() (*HealthSummary, error) {
+	return summarizeHealth([]healthProbe{
+		{"repo", b.repoHealth},
+		{"daemon", daemonHealth},
+		{"sync", b.syncHealth},
+	}, healthCheckTimeout), nil
+}
+
+// summarizeHealth runs probes concurrently and aggregates their results in
+// probe order. A probe that does not finish within timeout is reported
+// yellow; its context is then canceled and it is waited for, so no bd
+// process outlives the summary.
+func summarizeHealth(probes []healthProbe, timeout time.Duration) *HealthSummary {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	var wg sync.WaitGroup
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
+
+	results := make([]chan HealthCheck, len(probes))
+	for i, p := range probes {
+		results[i] = make(chan HealthCheck, 1)
+		wg.Add(1)
+		go func(p healthProbe, out chan<- HealthCheck) {
+			defer wg.Done()
+			check := p.run(ctx)
+			check.Name = p.name
+			out <- check
+		}(p, results[i])
+	}
+
+	summary := &HealthSummary{Overall: HealthGreen}
+	for i, p := range probes {
+		var check HealthCheck
+		select {
+		case check = <-results[i]:
+		case <-ctx.Done():
+			// Prefer a result that is ready over the expired deadline
+			select {
+			case check = <-results[i]:
+			default:
+				check = HealthCheck{Name: p.name, Level: HealthYellow, Message: fmt.Sprintf("timed out after %s", timeout)}
+			}
+		}
+		if healthRank[check.Level] > healthRank[summary.Overall] {
+			summary.Overall = check.Level
+		}
+		summary.Checks = append(summary.Checks, check)
+	}
+	return summary
+}
+
+func (b *Beads) repoHealth(context.Context) HealthCheck {
+	kind, err := BeadsRepoKind(b.workDir)
+	switch {
+	case err != nil:
+		return HealthCheck{Level: HealthRed, Message: err.Error()}
+	case kind == RepoKindNone:
+		return HealthCheck{Level: HealthRed, Message: "no beads repository"}
+	case kind == RepoKindRedirect:
+		return HealthCheck{Level: HealthGreen, Message: "redirect to " + ResolveBeadsDir(b.workDir)}
+	}
+	return HealthCheck{Level: HealthGreen, Message: ResolveBeadsDir(b.workDir)}
+}
+
+func daemonHealth(ctx context.Context) HealthCheck {
+	health, err := checkBdDaemonHealth(ctx)
+	if err != nil {
+		return HealthCheck{Level: HealthYellow, Message: err.Error()}
+	}
+	if health == nil || health.Total == 0 {
+		return HealthCheck{Level: HealthGreen, Message: "no daemons (direct mode)"}
+	}
+	if unhealthy := health.Stale + health.Mismatched + health.Unresponsive; unhealthy > 0 {
+		return HealthCheck{Level: HealthYellow, Message: fmt.Sprintf("%d of %d daemon(s) unhealthy", unhealthy, health.Total)}
+	}
+	return HealthCheck{Level: HealthGreen, Message: fmt.Sprintf("%d daemon(s) healthy", health.Healthy)}
+}
+
+func (b *Beads) syncHealth(ctx context.Context) HealthCheck {
+	status, err := b.syncStatus(ctx)
+	if err != nil {
+		return HealthCheck{Level: HealthYellow, Message: err.Error()}
+	}
+	if len(status.Conflicts) > 0 {
+		return HealthCheck{Level: HealthRed, Message: fmt.Sprintf("%d sync conflict(s)", len(status.Conflicts))}
+	}
+	if status.Behind > 0 {
+		return HealthCheck{Level: HealthYellow, Message: fmt.Sprintf("%d commit(s) behind", status.Behind)}
+	}
+	return HealthCheck{Level: HealthGreen, Message: "in sync"}
+}
@@ -0,0 +1,132 @@
+package beads
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestSummarizeHealth(t *testing.T) {
+	green := func(context.Context) HealthCheck { return HealthCheck{Level: HealthGreen} }
+	yellow := func(context.Context) HealthCheck { return HealthCheck{Level: HealthYellow} }
+	red := func(context.Context) HealthCheck { return HealthCheck{Level: HealthRed} }
+
+	tests := []struct {
+		name   string
+		probes []healthProbe
+		want   HealthLevel
+	}{
+		{"all green", []healthProbe{{"a", green}, {"b", green}}, HealthGreen},
+		{"worst is yellow", []healthProbe{{"a", green}, {"b", yellow}}, HealthYellow},
+		{"red wins", []healthProbe{{"a", yellow}, {"b", red}, {"c", green}}, HealthRed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := summarizeHealth(tt.probes, time.Second)
+			if got.Overall != tt.want {
+				t.Errorf("Overall = %s, want %s", got.Overall, tt.want)
+			}
+			if len(got.Checks) != len(tt.probes) {
+				t.Fatalf("got %d checks, want %d", len(got.Checks), len(tt.probes))
+			}
+			for i, p := range tt.probes {
+				if got.Checks[i].Name != p.name {
+					t.Errorf("Checks[%d].Name = %q, want %q", i, got.Checks[i].Name, p.name)
+				}
+			}
+		})
+	}
+}
+
+func TestSummarizeHealthTimeout(t *testing.T) {
+	slow := func(ctx context.Context) HealthCheck { <-ctx.Done(); return HealthCheck{Level: HealthGreen} }
+	fast := func(context.Context) HealthCheck { return HealthCheck{Level: HealthGreen, Message: "ok"} }
+
+	start := time.Now()
+	got := summarizeHealth([]healthProbe{{"slow", slow}, {"fast", fast}, {"slow2", slow}}, 50*time.Millisecond)
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("summary blocked on slow probe for %s", elapsed)
+	}
+
+	if got.Overall != HealthYellow {
+		t.Errorf("Overall = %s, want yellow", got.Overall)
+	}
+	if got.Checks[0].Level != HealthYellow {
+		t.Errorf("slow probe level = %s, want yellow", got.Checks[0].Level)
+	}
+	if got.Checks[2].Level != HealthYellow {
+		t.Errorf("second slow probe level = %s, want yellow", got.Checks[2].Level)
+	}
+	if got.Checks[1].Message != "ok" {
+		t.Errorf("fast probe result lost: %+v", got.Checks[1])
+	}
+}
+
+func TestRepoHealth(t *testing.T) {
+	root := t.TempDir()
+	rig := filepath.Join(root, "rig")
+	if err := os.MkdirAll(filepath.Join(rig, ".beads"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	worktree := filepath.Join(root, "crew", "max")
+	writeRedirect(t, worktree, "../../rig/.beads")
+	dangling := filepath.Join(root, "crew", "gone")
+	writeRedirect(t, dangling, "../../missing/.beads")
+
+	tests := []struct {
+		name    string
+		workDir string
+		level   HealthLevel
+		message string
+	}{
+		{"real repo", rig, HealthGreen, filepath.Join(rig, ".beads")},
+		{"redirect", worktree, HealthGreen, "redirect to " + filepath.Join(rig, ".beads")},
+		{"dangling redirect", dangling, HealthRed, "dangling redirect"},
+		{"no repo", root, HealthRed, "no beads repository"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := New(tt.workDir).repoHealth(context.Background())
+			if got.Level != tt.level || !strings.Contains(got.Message, tt.message) {
+				t.Errorf("repoHealth() = %s %q, want %s containing %q", got.Level, got.Message, tt.level, tt.message)
+			}
+		})
+	}
+}
+
+func TestSummarizeHealthKillsTimedOutBd(t *testing.T) {
+	installBDStub(t, `
+case "$cmd" in
+  sync) echo $$ > "${BD_LOG}.pid"; exec sleep 30 ;;
+esac
+`)
+	b := New(t.TempDir())
+
+	start := time.Now()
+	got := summarizeHealth([]healthProbe{{"sync", b.syncHealth}}, 100*time.Millisecond)
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("summary waited %s for the timed-out bd", elapsed)
+	}
+	if got.Checks[0].Level != HealthYellow {
+		t.Errorf("sync level = %s, want yellow", got.Checks[0].Level)
+	}
+
+	data, err := os.ReadFile(os.Getenv("BD_LOG") + ".pid")
+	if err != nil {
+		t.Fatalf("bd stub never started: %v", err)
+	}
+	pid, err := strconv.Atoi(strings.TrimSpace(string(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The process was killed and reaped, so it no longer exists
+	if proc, err := os.FindProcess(pid); err == nil && proc.Signal(syscall.Signal(0)) == nil {
+		t.Errorf("bd (pid %d) still running after the summary returned", pid)
+	}
+}
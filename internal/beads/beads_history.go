@@ -0,0 +1,155 @@
+// Package beads provides an issue's event history assembled from bd records.
+package beads
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// IssueEvent is one entry in an issue's history.
+type IssueEvent struct {
+	Time   string `json:"time"`            // RFC 3339 timestamp
+	Kind   string `json:"kind"`            // created, status, assigned, unassigned, comment, attached, detach, burn, squash, hooked, unhooked, closed
+	Actor  string `json:"actor,omitempty"` // Who caused the event, if known
+	Detail string `json:"detail,omitempty"`
+}
+
+// issueComment mirrors an entry of `bd comments --json`.
+type issueComment struct {
+	Author    string `json:"author"`
+	Text      string `json:"text"`
+	CreatedAt string `json:"created_at"`
+}
+
+// IssueHistory returns the recorded events for an issue in time order:
+// creation from the issue itself, status and assignee changes made through
+// gt, comments, the molecule attachment, detach operations from the audit
+// log, (for agent beads) hook changes from the hook history, and close. A
+// close made with bd directly is reported from the issue, with no actor.
+func (b *Beads) IssueHistory(id string) ([]IssueEvent, error) {
+	issue, err := b.Show(id)
+	if err != nil {
+		return nil, err
+	}
+
+	events := []IssueEvent{{
+		Time:   issue.CreatedAt,
+		Kind:   "created",
+		Actor:  issue.CreatedBy,
+		Detail: issue.Title,
+	}}
+
+	out, err := b.run("comments", id, "--json")
+	if err != nil {
+		return nil, fmt.Errorf("listing comments: %w", err)
+	}
+	var comments []issueComment
+	if err := json.Unmarshal(out, &comments); err != nil {
+		return nil, fmt.Errorf("parsing bd comments output: %w", err)
+	}
+	for _, c := range comments {
+		events = append(events, IssueEvent{Time: c.CreatedAt, Kind: "comment", Actor: c.Author, Detail: c.Text})
+	}
+
+	if fields := ParseAttachmentFields(issue); fields != nil && fields.AttachedMolecule != "" {
+		events = append(events, IssueEvent{
+			Time:   fields.AttachedAt,
+			Kind:   "attached",
+			Actor:  fields.DispatchedBy,
+			Detail: fields.AttachedMolecule,
+		})
+	}
+
+	events = append(events, b.detachAuditEvents(id)...)
+
+	// Agent beads: which work passed through the hook
+	hooks, _ := b.HookHistory(id)
+	for _, h := range hooks {
+		kind := "hooked"
+		if h.Action == HookActionClear {
+			kind = "unhooked"
+		}
+		events = append(events, IssueEvent{Time: h.Timestamp, Kind: kind, Detail: h.HookBead})
+	}
+
+	transitions, err := b.transitions(id)
+	if err != nil {
+		return nil, err
+	}
+	from := "open"
+	for _, tr := range transitions {
+		if tr.To == "closed" {
+			events = append(events, IssueEvent{Time: tr.Timestamp, Kind: "closed", Actor: tr.Actor, Detail: "from " + from})
+		} else if tr.To != "" {
+			events = append(events, IssueEvent{Time: tr.Timestamp, Kind: "status", Actor: tr.Actor, Detail: from + " → " + tr.To})
+		}
+		if tr.To != "" {
+			from = tr.To
+		}
+		if tr.Assignee != nil {
+			if *tr.Assignee == "" {
+				events = append(events, IssueEvent{Time: tr.Timestamp, Kind: "unassigned", Actor: tr.Actor})
+			} else {
+				events = append(events, IssueEvent{Time: tr.Timestamp, Kind: "assigned", Actor: tr.Actor, Detail: *tr.Assignee})
+			}
+		}
+	}
+	if issue.ClosedAt != "" && from != "closed" {
+		events = append(events, IssueEvent{Time: issue.ClosedAt, Kind: "closed"})
+	}
+
+	sortIssueEvents(events)
+	return events, nil
+}
+
+// detachAuditEvents reads detach/burn/squash entries for id from the audit
+// log written by LogDetachAudit. A missing or unreadable log yields none.
+func (b *Beads) detachAuditEvents(id string) []IssueEvent {
+	f, err := os.Open(filepath.Join(b.resolvedBeadsDir(), "audit.log")) //nolint:gosec // G304: path is constructed internally
+	if err != nil {
+		return nil
+	}
+	defer f.Close()
+
+	var events []IssueEvent
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var entry DetachAuditEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil || entry.PinnedBeadID != id {
+			continue
+		}
+		detail := entry.DetachedMolecule
+		if entry.Reason != "" {
+			detail += " (" + entry.Reason + ")"
+		}
+		events = append(events, IssueEvent{
+			Time:   entry.Timestamp,
+			Kind:   entry.Operation,
+			Actor:  entry.DetachedBy,
+			Detail: strings.TrimSpace(detail),
+		})
+	}
+	return events
+}
+
+// sortIssueEvents orders events by time, keeping the original order for
+// ties. Events whose time cannot be parsed sort last.
+func sortIssueEvents(events []IssueEvent) {
+	parse := func(s string) time.Time {
+		t, _ := time.Parse(time.RFC3339, s)
+		return t
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		ti, tj := parse(events[i].Time), parse(events[j].Time)
+		if ti.IsZero() || tj.IsZero() {
+			return !ti.IsZero()
+		}
+		return ti.Before(tj)
+	})
+}
@@ -0,0 +1,213 @@
+package beads
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestIssueHistory(t *testing.T) {
+	installBDStub(t, `
+case "$cmd" in
+  show)
+    printf '%s\n' '[{"id":"gt-abc","title":"Fix widget","status":"closed","created_at":"2026-01-05T09:00:00Z","created_by":"mayor","closed_at":"2026-01-05T12:00:00Z","description":"attached_molecule: gt-wisp-1\nattached_at: 2026-01-05T10:00:00Z\ndispatched_by: mayor/"}]'
+    ;;
+  comments)
+    printf '%s\n' '[{"author":"gastown/polecats/Toast","text":"halfway","created_at":"2026-01-05T11:00:00Z"}]'
+    ;;
+esac
+`)
+	plain := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(plain, ".beads"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	worktree, rig := redirectedWorktree(t)
+
+	tests := []struct {
+		name, workDir, beadsDir string
+	}{
+		{"repo", plain, filepath.Join(plain, ".beads")},
+		{"redirected worktree", worktree, filepath.Join(rig, ".beads")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := New(tt.workDir)
+			if err := b.LogDetachAudit(DetachAuditEntry{
+				Timestamp:        "2026-01-05T11:30:00Z",
+				Operation:        "burn",
+				PinnedBeadID:     "gt-abc",
+				DetachedMolecule: "gt-wisp-1",
+				DetachedBy:       "gastown/witness",
+			}); err != nil {
+				t.Fatal(err)
+			}
+			if err := b.LogDetachAudit(DetachAuditEntry{Timestamp: "2026-01-05T09:30:00Z", Operation: "detach", PinnedBeadID: "gt-other"}); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := os.Stat(filepath.Join(tt.beadsDir, "audit.log")); err != nil {
+				t.Errorf("audit log not in %s: %v", tt.beadsDir, err)
+			}
+
+			events, err := b.IssueHistory("gt-abc")
+			if err != nil {
+				t.Fatalf("IssueHistory: %v", err)
+			}
+
+			want := []struct{ kind, actor string }{
+				{"created", "mayor"},
+				{"attached", "mayor/"},
+				{"comment", "gastown/polecats/Toast"},
+				{"burn", "gastown/witness"},
+				{"closed", ""},
+			}
+			if len(events) != len(want) {
+				t.Fatalf("got %d events, want %d: %+v", len(events), len(want), events)
+			}
+			for i, w := range want {
+				if events[i].Kind != w.kind || events[i].Actor != w.actor {
+					t.Errorf("events[%d] = %s by %q, want %s by %q", i, events[i].Kind, events[i].Actor, w.kind, w.actor)
+				}
+			}
+		})
+	}
+}
+
+func TestSortIssueEventsUnparsableLast(t *testing.T) {
+	events := []IssueEvent{
+		{Time: "", Kind: "attached"},
+		{Time: "2026-01-05T12:00:00Z", Kind: "closed"},
+		{Time: "2026-01-05T09:00:00Z", Kind: "created"},
+	}
+	sortIssueEvents(events)
+	if events[0].Kind != "created" || events[1].Kind != "closed" || events[2].Kind != "attached" {
+		t.Errorf("unexpected order: %+v", events)
+	}
+}
+
+func TestHookHistory(t *testing.T) {
+	installBDStub(t, `
+case "$cmd" in
+  slot)
+    if [ "$1 $4" = "set gt-b" ] && [ ! -f "$BD_LOG.occupied" ]; then
+      touch "$BD_LOG.occupied"
+      echo "slot hook already occupied" >&2
+      exit 1
+    fi
+    ;;
+esac
+`)
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, ".beads"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	b := New(dir)
+	agent := "gt-gastown-polecat-Toast"
+
+	if err := b.SetHookBead(agent, "gt-a"); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.SetHookBead(agent, "gt-b"); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.SetHookBead("gt-gastown-polecat-Nux", "gt-c"); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.ClearHookBead(agent); err != nil {
+		t.Fatal(err)
+	}
+
+	events, err := b.HookHistory(agent)
+	if err != nil {
+		t.Fatalf("HookHistory: %v", err)
+	}
+	want := []struct{ action, bead string }{
+		{HookActionSet, "gt-a"},
+		{HookActionSet, "gt-b"},
+		{HookActionClear, ""},
+	}
+	if len(events) != len(want) {
+		t.Fatalf("got %d events, want %d: %+v", len(events), len(want), events)
+	}
+	for i, w := range want {
+		if events[i].Action != w.action || events[i].HookBead != w.bead || events[i].Timestamp == "" {
+			t.Errorf("events[%d] = %+v, want %s %q", i, events[i], w.action, w.bead)
+		}
+	}
+
+	if empty, err := b.HookHistory("gt-gastown-polecat-Slit"); err != nil || len(empty) != 0 {
+		t.Errorf("HookHistory(unknown) = %+v, %v; want empty", empty, err)
+	}
+}
+
+func TestHookHistoryFromRedirectedWorktree(t *testing.T) {
+	installBDStub(t, "")
+	worktree, rig := redirectedWorktree(t)
+	agent := "gt-gastown-polecat-Toast"
+
+	if err := New(worktree).SetHookBead(agent, "gt-a"); err != nil {
+		t.Fatal(err)
+	}
+
+	events, err := New(rig).HookHistory(agent)
+	if err != nil {
+		t.Fatalf("HookHistory: %v", err)
+	}
+	if len(events) != 1 || events[0].HookBead != "gt-a" {
+		t.Errorf("HookHistory from rig = %+v, want gt-a set", events)
+	}
+}
+
+func TestIssueHistoryStatusAndAssignee(t *testing.T) {
+	installBDStub(t, `
+state="${BD_LOG}.status"
+[ -f "$state" ] || echo open > "$state"
+case "$cmd" in
+  show)
+    printf '[{"id":"gt-abc","title":"Fix widget","status":"%s","created_at":"2026-01-05T09:00:00Z","created_by":"mayor","closed_at":"2026-01-05T12:00:00Z"}]\n' "$(cat "$state")"
+    ;;
+  comments) echo '[]' ;;
+  update)
+    for arg in "$@"; do
+      case "$arg" in --status=*) echo "${arg#--status=}" > "$state" ;; esac
+    done
+    ;;
+  close) echo closed > "$state" ;;
+esac
+`)
+	b := newTransitionBeads(t)
+
+	t.Setenv("BD_ACTOR", "mayor")
+	status, assignee := "hooked", "gastown/polecats/Toast"
+	if err := b.Update("gt-abc", UpdateOptions{Status: &status, Assignee: &assignee}); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("BD_ACTOR", "gastown/witness")
+	if err := b.Release("gt-abc"); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("BD_ACTOR", "gastown/polecats/Nux")
+	if err := b.Close("gt-abc"); err != nil {
+		t.Fatal(err)
+	}
+
+	events, err := b.IssueHistory("gt-abc")
+	if err != nil {
+		t.Fatalf("IssueHistory: %v", err)
+	}
+	want := []struct{ kind, actor, detail string }{
+		{"created", "mayor", "Fix widget"},
+		{"status", "mayor", "open → hooked"},
+		{"assigned", "mayor", "gastown/polecats/Toast"},
+		{"status", "gastown/witness", "hooked → open"},
+		{"unassigned", "gastown/witness", ""},
+		{"closed", "gastown/polecats/Nux", "from open"},
+	}
+	if len(events) != len(want) {
+		t.Fatalf("got %d events, want %d: %+v", len(events), len(want), events)
+	}
+	for i, w := range want {
+		if events[i].Kind != w.kind || events[i].Actor != w.actor || events[i].Detail != w.detail {
+			t.Errorf("events[%d] = %+v, want %s by %q (%q)", i, events[i], w.kind, w.actor, w.detail)
+		}
+	}
+}
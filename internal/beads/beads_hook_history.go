@@ -0,0 +1,77 @@
+// Package beads provides the history of beads hooked to an agent.
+package beads
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// hookHistoryFile is the JSONL log of hook slot changes, kept next to
+// audit.log. bd's slot columns hold only the current hook, so this log is
+// the only record of what an agent worked on before.
+const hookHistoryFile = "hook-history.log"
+
+// Hook history actions.
+const (
+	HookActionSet   = "set"
+	HookActionClear = "clear"
+)
+
+// HookEvent is one change to an agent bead's hook slot.
+type HookEvent struct {
+	Timestamp   string `json:"timestamp"`
+	AgentBeadID string `json:"agent_bead_id"`
+	Action      string `json:"action"`              // HookActionSet or HookActionClear
+	HookBead    string `json:"hook_bead,omitempty"` // Bead put on the hook (set only)
+}
+
+// logHookEvent appends a hook change to the hook history. Recording is
+// best-effort: a failure must never fail the hook update itself.
+func (b *Beads) logHookEvent(agentBeadID, action, hookBead string) {
+	data, err := json.Marshal(HookEvent{
+		Timestamp:   currentTimestamp(),
+		AgentBeadID: agentBeadID,
+		Action:      action,
+		HookBead:    hookBead,
+	})
+	if err != nil {
+		return
+	}
+	path := filepath.Join(b.resolvedBeadsDir(), hookHistoryFile)
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600) //nolint:gosec // G304: path is constructed internally
+	if err != nil {
+		return
+	}
+	defer f.Close()
+	_, _ = f.Write(append(data, '\n'))
+}
+
+// HookHistory returns the hook changes recorded for an agent bead, oldest
+// first. An agent with no recorded changes has an empty history.
+func (b *Beads) HookHistory(agentBeadID string) ([]HookEvent, error) {
+	f, err := os.Open(filepath.Join(b.resolvedBeadsDir(), hookHistoryFile)) //nolint:gosec // G304: path is constructed internally
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("opening hook history: %w", err)
+	}
+	defer f.Close()
+
+	var events []HookEvent
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var ev HookEvent
+		if err := json.Unmarshal(scanner.Bytes(), &ev); err != nil || ev.AgentBeadID != agentBeadID {
+			continue
+		}
+		events = append(events, ev)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading hook history: %w", err)
+	}
+	return events, nil
+}
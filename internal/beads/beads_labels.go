@@ -0,0 +1,226 @@
+package beads
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidLabel is returned by Create and Update in strict mode when a
+// label does not fit the Beads value's label taxonomy.
+var ErrInvalidLabel = errors.New("invalid label")
+
+// LabelIssue describes one label that does not fit the taxonomy.
+type LabelIssue struct {
+	Label   string
+	Problem string
+}
+
+func (i LabelIssue) String() string {
+	return fmt.Sprintf("label %q: %s", i.Label, i.Problem)
+}
+
+// LabelTaxonomy describes the namespaced labels gt uses ("gt:task",
+// "queue:inbox", "thread:t-abc"). Labels without a namespace are free-form
+// and only checked for well-formedness.
+type LabelTaxonomy struct {
+	// Namespaces maps each known namespace to its allowed values. A nil
+	// slice allows any non-empty value.
+	Namespaces map[string][]string
+
+	// Strict makes Create and Update reject labels that do not fit instead
+	// of passing them to Warn.
+	Strict bool
+
+	// Warn receives each label that does not fit outside strict mode. When
+	// nil such labels are written without comment.
+	Warn func(LabelIssue)
+}
+
+// DefaultLabelTaxonomy returns the namespaces gt itself writes.
+func DefaultLabelTaxonomy() *LabelTaxonomy {
+	return &LabelTaxonomy{
+		Namespaces: map[string][]string{
+			"gt":          nil, // gt:<type>, gt:agent, gt:scheduled, ...
+			"queue":       nil,
+			"thread":      nil,
+			"from":        nil,
+			"reply-to":    nil,
+			"cc":          nil,
+			"msg-type":    nil,
+			"channel":     nil,
+			"announce":    nil,
+			"severity":    {"critical", "high", "medium", "low"},
+			"status":      nil,
+			"expires":     nil,
+			"not-before":  nil,
+			"reopens":     nil,
+			"claimed-by":  nil,
+			"claimed-at":  nil,
+			"migrated-to": nil,
+			"role_type":   nil,
+			"rig":         nil,
+			"location":    nil,
+		},
+	}
+}
+
+// ValidateLabels checks labels against the default taxonomy and returns the
+// ones that are malformed or use an unknown namespace.
+func ValidateLabels(labels []string) []LabelIssue {
+	return DefaultLabelTaxonomy().Validate(labels)
+}
+
+// WithLabelTaxonomy makes Create and Update check the labels they write
+// against t, and returns b. A nil taxonomy, the default, disables checking.
+func (b *Beads) WithLabelTaxonomy(t *LabelTaxonomy) *Beads {
+	b.labels = t
+	return b
+}
+
+// Validate checks labels against the taxonomy. A label is "namespace:value"
+// split at the first colon, so values may themselves contain colons.
+func (t *LabelTaxonomy) Validate(labels []string) []LabelIssue {
+	var issues []LabelIssue
+	for _, label := range labels {
+		if problem := t.check(label); problem != "" {
+			issues = append(issues, LabelIssue{Label: label, Problem: problem})
+		}
+	}
+	return issues
+}
+
+// check returns what is wrong with a label, or "" if it fits.
+func (t *LabelTaxonomy) check(label string) string {
+	if label == "" {
+		return "empty label"
+	}
+	if strings.ContainsAny(label, ", \t\n") {
+		return "contains a comma or whitespace"
+	}
+
+	namespace, value, namespaced := strings.Cut(label, ":")
+	if !namespaced {
+		return ""
+	}
+	if namespace == "" {
+		return "empty namespace"
+	}
+	if value == "" {
+		return "empty value"
+	}
+
+	allowed, known := t.Namespaces[namespace]
+	if !known {
+		return fmt.Sprintf("unknown namespace %q", namespace)
+	}
+	if allowed == nil {
+		return ""
+	}
+	for _, v := range allowed {
+		if value == v {
+			return ""
+		}
+	}
+	return fmt.Sprintf("%q is not one of %s", value, strings.Join(allowed, ", "))
+}
+
+// checkLabels validates labels about to be written against b's taxonomy.
+// Problems go to the taxonomy's Warn func, or are returned as
+// ErrInvalidLabel in strict mode.
+func (b *Beads) checkLabels(labels []string) error {
+	if b.labels == nil {
+		return nil
+	}
+	issues := b.labels.Validate(labels)
+	if len(issues) == 0 {
+		return nil
+	}
+	if b.labels.Strict {
+		msgs := make([]string, len(issues))
+		for i, issue := range issues {
+			msgs[i] = issue.String()
+		}
+		return fmt.Errorf("%w: %s", ErrInvalidLabel, strings.Join(msgs, "; "))
+	}
+	if b.labels.Warn != nil {
+		for _, issue := range issues {
+			b.labels.Warn(issue)
+		}
+	}
+	return nil
+}
+
+// renameLabelBatch bounds how many issues one bd update call relabels.
+const renameLabelBatch = 50
+
+// RenameLabel replaces oldLabel with newLabel on every issue in the database
+// that carries it, open or closed, and returns how many issues changed.
+// Issues are relabeled in batches, each batch adding the new label and
+// removing the old one in a single bd update; on error the returned count
+// covers the batches that completed, and rerunning finishes the rename.
+func (b *Beads) RenameLabel(oldLabel, newLabel string) (int, error) {
+	if oldLabel == newLabel {
+		return 0, nil
+	}
+	if err := b.checkLabels([]string{newLabel}); err != nil {
+		return 0, err
+	}
+
+	issues, err := b.List(ListOptions{Status: "all", Label: oldLabel, Priority: -1, NoCap: true})
+	if err != nil {
+		return 0, fmt.Errorf("listing issues labeled %s: %w", oldLabel, err)
+	}
+
+	renamed := 0
+	for start := 0; start < len(issues); start += renameLabelBatch {
+		end := min(start+renameLabelBatch, len(issues))
+		args := []string{"update"}
+		for _, issue := range issues[start:end] {
+			args = append(args, issue.ID)
+		}
+		args = append(args, "--add-label="+newLabel, "--remove-label="+oldLabel)
+		if _, err := b.run(args...); err != nil {
+			return renamed, fmt.Errorf("relabeling %s to %s: %w", oldLabel, newLabel, err)
+		}
+		renamed += end - start
+	}
+	return renamed, nil
+}
+
+// SetExclusiveLabel makes namespace:value the only label in its namespace on
+// an issue, for namespaces whose values are mutually exclusive (e.g. queue
+// states inbox, active, done). The other labels in the namespace are removed
+// and the new one added in a single bd update, so the issue never carries
+// two at once. Labels outside the namespace are left alone.
+func (b *Beads) SetExclusiveLabel(id, namespace, value string) error {
+	label := namespace + ":" + value
+	if err := b.checkLabels([]string{label}); err != nil {
+		return err
+	}
+
+	issue, err := b.Show(id)
+	if err != nil {
+		return fmt.Errorf("fetching %s: %w", id, err)
+	}
+
+	var remove []string
+	present := false
+	for _, l := range issue.Labels {
+		switch {
+		case l == label:
+			present = true
+		case strings.HasPrefix(l, namespace+":"):
+			remove = append(remove, l)
+		}
+	}
+	if present && len(remove) == 0 {
+		return nil
+	}
+
+	opts := UpdateOptions{RemoveLabels: remove}
+	if !present {
+		opts.AddLabels = []string{label}
+	}
+	return b.Update(id, opts)
+}
@@ -0,0 +1,263 @@
+package beads
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestValidateLabels(t *testing.T) {
+	tests := []struct {
+		label   string
+		problem string // substring of the expected problem; "" if valid
+	}{
+		{"gt:task", ""},
+		{"queue:inbox", ""},
+		{"thread:t-abc", ""},
+		{"expires:2026-01-02T15:04:05Z", ""}, // value contains colons
+		{"severity:high", ""},
+		{"digest", ""}, // free-form labels are allowed
+		{"frobnicate:x", `unknown namespace "frobnicate"`},
+		{"severity:urgent", `"urgent" is not one of`},
+		{"", "empty label"},
+		{"gt:", "empty value"},
+		{":task", "empty namespace"},
+		{"gt:task,bug", "comma or whitespace"},
+		{"queue:my inbox", "comma or whitespace"},
+	}
+
+	for _, tt := range tests {
+		issues := ValidateLabels([]string{tt.label})
+		if tt.problem == "" {
+			if len(issues) != 0 {
+				t.Errorf("ValidateLabels(%q) = %v, want valid", tt.label, issues)
+			}
+			continue
+		}
+		if len(issues) != 1 || !strings.Contains(issues[0].Problem, tt.problem) {
+			t.Errorf("ValidateLabels(%q) = %v, want problem containing %q", tt.label, issues, tt.problem)
+		}
+	}
+}
+
+func TestLabelTaxonomyConfigurable(t *testing.T) {
+	custom := &LabelTaxonomy{Namespaces: map[string][]string{"team": {"infra", "web"}}}
+	if issues := custom.Validate([]string{"team:infra", "team:web"}); len(issues) != 0 {
+		t.Errorf("Validate = %v, want no issues", issues)
+	}
+	issues := custom.Validate([]string{"team:ops", "gt:task"})
+	if len(issues) != 2 {
+		t.Fatalf("Validate = %v, want 2 issues", issues)
+	}
+	if issues[0].Label != "team:ops" || issues[1].Label != "gt:task" {
+		t.Errorf("Validate flagged %v, want team:ops and gt:task", issues)
+	}
+}
+
+func TestCheckLabelsStrict(t *testing.T) {
+	calls := installBDStub(t, `
+case "$cmd" in
+  create) printf '%s\n' '{"id":"gt-new","title":"New"}' ;;
+esac
+`)
+	strict := DefaultLabelTaxonomy()
+	strict.Strict = true
+	b := New(t.TempDir()).WithLabelTaxonomy(strict)
+
+	err := b.Update("gt-abc", UpdateOptions{AddLabels: []string{"frobnicate:x"}})
+	if !errors.Is(err, ErrInvalidLabel) {
+		t.Fatalf("Update with unknown namespace = %v, want ErrInvalidLabel", err)
+	}
+	if hasCall(calls(), "update") {
+		t.Errorf("bd update ran despite invalid label: %v", calls())
+	}
+
+	if _, err := b.Create(CreateOptions{Title: "New", Type: "has space", Priority: -1}); !errors.Is(err, ErrInvalidLabel) {
+		t.Errorf("Create with malformed type = %v, want ErrInvalidLabel", err)
+	}
+
+	if err := b.Update("gt-abc", UpdateOptions{AddLabels: []string{"gt:task"}}); err != nil {
+		t.Errorf("Update with valid label: %v", err)
+	}
+
+	// Outside strict mode, problems go to Warn and the write proceeds.
+	var warned []string
+	lenient := DefaultLabelTaxonomy()
+	lenient.Warn = func(issue LabelIssue) { warned = append(warned, issue.Label) }
+	b.WithLabelTaxonomy(lenient)
+	if err := b.Update("gt-abc", UpdateOptions{AddLabels: []string{"frobnicate:x"}}); err != nil {
+		t.Errorf("non-strict Update = %v, want warning only", err)
+	}
+	if strings.Join(warned, ",") != "frobnicate:x" {
+		t.Errorf("warned about %v, want frobnicate:x", warned)
+	}
+
+	// Without a taxonomy, labels are not checked.
+	b.WithLabelTaxonomy(nil)
+	if err := b.Update("gt-abc", UpdateOptions{AddLabels: []string{"frobnicate:x"}}); err != nil {
+		t.Errorf("unchecked Update = %v", err)
+	}
+	if len(warned) != 1 {
+		t.Errorf("warned without a taxonomy: %v", warned)
+	}
+}
+
+func TestRenameLabel(t *testing.T) {
+	// The stub relabels on update and lists accordingly afterwards.
+	calls := installBDStub(t, `
+renamed="${BD_LOG}.renamed"
+case "$cmd" in
+  list)
+    case "$*" in
+      *--label=gt:bug*)
+        if [ -f "$renamed" ]; then echo '[]'; else
+          printf '%s\n' '[{"id":"gt-a","labels":["gt:bug"]},{"id":"gt-b","status":"closed","labels":["gt:bug","p1"]},{"id":"gt-c","labels":["gt:bug"]}]'
+        fi ;;
+      *) echo '[]' ;;
+    esac
+    ;;
+  update) touch "$renamed" ;;
+esac
+`)
+	b := New(t.TempDir())
+
+	n, err := b.RenameLabel("gt:bug", "status:bug")
+	if err != nil {
+		t.Fatalf("RenameLabel: %v", err)
+	}
+	if n != 3 {
+		t.Errorf("RenameLabel renamed %d issues, want 3", n)
+	}
+	if !hasCall(calls(), "list", "--status=all", "--label=gt:bug") {
+		t.Errorf("closed issues not included: %v", calls())
+	}
+	if !hasCall(calls(), "update gt-a gt-b gt-c", "--add-label=status:bug", "--remove-label=gt:bug") {
+		t.Errorf("issues not relabeled in one batch: %v", calls())
+	}
+
+	left, err := b.List(ListOptions{Status: "all", Label: "gt:bug", Priority: -1})
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(left) != 0 {
+		t.Errorf("issues still labeled gt:bug: %v", issueIDs(left))
+	}
+
+	// Nothing left to rename
+	if n, err := b.RenameLabel("gt:bug", "status:bug"); err != nil || n != 0 {
+		t.Errorf("second RenameLabel = %d, %v; want 0, nil", n, err)
+	}
+}
+
+func TestCreateLabelsDedup(t *testing.T) {
+	calls := installBDStub(t, `
+case "$cmd" in
+  create) printf '%s\n' '{"id":"gt-new","title":"New","labels":["gt:task","frontend"]}' ;;
+esac
+`)
+	b := New(t.TempDir())
+
+	opts := CreateOptions{
+		Title:    "New",
+		Type:     "task",
+		Priority: -1,
+		Labels:   []string{"gt:task", " frontend ", "", "frontend", "Frontend"},
+	}
+	if _, err := b.Create(opts); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	var create string
+	for _, call := range calls() {
+		if strings.Contains(call, "create") {
+			create = call
+		}
+	}
+	if n := strings.Count(create, "--labels=gt:task"); n != 1 {
+		t.Errorf("gt:task passed %d times, want once: %s", n, create)
+	}
+	if n := strings.Count(create, "--labels=frontend"); n != 1 {
+		t.Errorf("frontend passed %d times, want once (trimmed): %s", n, create)
+	}
+	if !strings.Contains(create, "--labels=Frontend") {
+		t.Errorf("dedup should be case-sensitive: %s", create)
+	}
+	if strings.Contains(create, "--labels= ") || strings.Contains(create, "--labels= frontend") {
+		t.Errorf("labels not trimmed: %s", create)
+	}
+
+	got := createLabels(opts)
+	if want := []string{"gt:task", "frontend", "Frontend"}; strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("createLabels = %v, want %v", got, want)
+	}
+}
+
+func TestSetExclusiveLabel(t *testing.T) {
+	// The stub keeps the bead's labels in a file, one per line.
+	calls := installBDStub(t, `
+labels="${BD_LOG}.labels"
+[ -f "$labels" ] || printf 'gt:task\nqueue:inbox\n' > "$labels"
+case "$cmd" in
+  show)
+    printf '[{"id":"gt-abc","labels":['
+    sep=""
+    while read -r l; do printf '%s"%s"' "$sep" "$l"; sep=","; done < "$labels"
+    printf ']}]\n'
+    ;;
+  update)
+    for arg in "$@"; do
+      case "$arg" in
+        --add-label=*) echo "${arg#--add-label=}" >> "$labels" ;;
+        --remove-label=*) grep -vx "${arg#--remove-label=}" "$labels" > "$labels.tmp"; mv "$labels.tmp" "$labels" ;;
+      esac
+    done
+    ;;
+esac
+`)
+	b := New(t.TempDir())
+
+	queueLabels := func() []string {
+		t.Helper()
+		issue, err := b.Show("gt-abc")
+		if err != nil {
+			t.Fatalf("Show: %v", err)
+		}
+		var got []string
+		for _, l := range issue.Labels {
+			if strings.HasPrefix(l, "queue:") {
+				got = append(got, l)
+			}
+		}
+		if !HasLabel(issue, "gt:task") {
+			t.Errorf("labels outside the namespace changed: %v", issue.Labels)
+		}
+		return got
+	}
+
+	for _, state := range []string{"active", "done", "inbox"} {
+		if err := b.SetExclusiveLabel("gt-abc", "queue", state); err != nil {
+			t.Fatalf("SetExclusiveLabel(%s): %v", state, err)
+		}
+		if got := queueLabels(); len(got) != 1 || got[0] != "queue:"+state {
+			t.Errorf("after moving to %s, queue labels = %v", state, got)
+		}
+	}
+
+	updates := 0
+	for _, call := range calls() {
+		if strings.Contains(call, "update gt-abc") {
+			updates++
+		}
+	}
+	if updates != 3 {
+		t.Errorf("bd update ran %d times, want one per state change", updates)
+	}
+
+	// Already in the state: no update
+	if err := b.SetExclusiveLabel("gt-abc", "queue", "inbox"); err != nil {
+		t.Fatalf("SetExclusiveLabel(inbox) again: %v", err)
+	}
+	if last := calls()[len(calls())-1]; strings.Contains(last, "update gt-abc") {
+		t.Errorf("unexpected update when label already set: %s", last)
+	}
+}
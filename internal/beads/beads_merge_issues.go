@@ -0,0 +1,119 @@
+// Package beads provides merging of duplicate beads into a survivor.
+package beads
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// MergeIssues folds mergeIDs into keepID: each merged bead's dependencies
+// and dependents are rewired onto keepID (keeping their dependency type),
+// its labels and comments are copied over, and it is closed with a
+// "merged into <keepID>" reason. Edges between the beads being merged are
+// dropped rather than turned into self-dependencies. Parent-child edges are
+// not moved: keepID keeps its own place in the hierarchy, and the merged
+// beads' children stay under them.
+func (b *Beads) MergeIssues(keepID string, mergeIDs ...string) error {
+	if len(mergeIDs) == 0 {
+		return nil
+	}
+	for _, id := range mergeIDs {
+		if id == keepID {
+			return fmt.Errorf("cannot merge %s into itself", keepID)
+		}
+	}
+	issues, err := b.showFound(append([]string{keepID}, mergeIDs...))
+	if err != nil {
+		return err
+	}
+	keep := issues[keepID]
+	if keep == nil {
+		return fmt.Errorf("%s: %w", keepID, ErrNotFound)
+	}
+
+	merging := map[string]bool{keepID: true}
+	for _, id := range mergeIDs {
+		if issues[id] == nil {
+			return fmt.Errorf("%s: %w", id, ErrNotFound)
+		}
+		merging[id] = true
+	}
+
+	hasLabel := make(map[string]bool, len(keep.Labels))
+	for _, label := range keep.Labels {
+		hasLabel[label] = true
+	}
+
+	for _, id := range mergeIDs {
+		issue := issues[id]
+		for _, dep := range issue.Dependencies {
+			if merging[dep.ID] || dep.DependencyType == DepTypeParentChild {
+				continue
+			}
+			if err := b.AddTypedDependency(keepID, dep.ID, depType(dep)); err != nil {
+				return fmt.Errorf("moving dependency %s -> %s onto %s: %w", id, dep.ID, keepID, err)
+			}
+		}
+		for _, dep := range issue.Dependents {
+			if merging[dep.ID] || dep.DependencyType == DepTypeParentChild {
+				continue
+			}
+			if err := b.AddTypedDependency(dep.ID, keepID, depType(dep)); err != nil {
+				return fmt.Errorf("moving dependent %s -> %s onto %s: %w", dep.ID, id, keepID, err)
+			}
+			if err := b.RemoveDependency(dep.ID, id); err != nil {
+				return fmt.Errorf("unlinking dependent %s from %s: %w", dep.ID, id, err)
+			}
+		}
+
+		var labels []string
+		for _, label := range issue.Labels {
+			if !hasLabel[label] {
+				hasLabel[label] = true
+				labels = append(labels, label)
+			}
+		}
+		if len(labels) > 0 {
+			if err := b.Update(keepID, UpdateOptions{AddLabels: labels}); err != nil {
+				return fmt.Errorf("copying labels from %s: %w", id, err)
+			}
+		}
+
+		if err := b.copyComments(id, keepID); err != nil {
+			return err
+		}
+
+		if err := b.CloseWithReason("merged into "+keepID, id); err != nil {
+			return fmt.Errorf("closing %s: %w", id, err)
+		}
+	}
+	return nil
+}
+
+// depType returns a dependency's type, defaulting to blocking as bd does.
+func depType(dep IssueDep) string {
+	if dep.DependencyType == "" {
+		return DepTypeBlocks
+	}
+	return dep.DependencyType
+}
+
+// copyComments re-posts from's comments on to, attributed to their
+// original author and bead.
+func (b *Beads) copyComments(from, to string) error {
+	out, err := b.run("comments", from, "--json")
+	if err != nil {
+		return fmt.Errorf("listing comments on %s: %w", from, err)
+	}
+	var comments []issueComment
+	if err := json.Unmarshal(out, &comments); err != nil {
+		return fmt.Errorf("parsing bd comments output: %w", err)
+	}
+	for _, c := range comments {
+		text := fmt.Sprintf("[from %s, %s] %s", from, c.Author, c.Text)
+		if _, err := b.run("comments", "add", to, text); err != nil {
+			return fmt.Errorf("copying comment from %s: %w", from, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package beads
+
+import "testing"
+
+func TestMergeIssues(t *testing.T) {
+	calls := installBDStub(t, `
+case "$cmd" in
+  show)
+    printf '%s\n' '[
+      {"id":"gt-keep","labels":["gt:task"]},
+      {"id":"gt-m1","labels":["gt:task","area:ui"],"dependencies":[{"id":"gt-a","dependency_type":"blocks"},{"id":"gt-epic","dependency_type":"parent-child"}],"dependents":[{"id":"gt-d1","dependency_type":"blocks"},{"id":"gt-m1.1","dependency_type":"parent-child"}]},
+      {"id":"gt-m2","dependencies":[{"id":"gt-b","dependency_type":"tracks"},{"id":"gt-m1"}]}
+    ]'
+    ;;
+  comments)
+    case "$1" in
+      gt-m1) printf '%s\n' '[{"author":"gastown/polecats/Toast","text":"repro attached"}]' ;;
+      add) ;;
+      *) echo '[]' ;;
+    esac
+    ;;
+esac
+`)
+
+	if err := New(t.TempDir()).MergeIssues("gt-keep", "gt-m1", "gt-m2"); err != nil {
+		t.Fatalf("MergeIssues: %v", err)
+	}
+
+	got := calls()
+	for _, want := range [][]string{
+		{"dep add gt-keep gt-a", "--type=blocks"},
+		{"dep add gt-keep gt-b", "--type=tracks"},
+		{"dep add gt-d1 gt-keep", "--type=blocks"},
+		{"dep remove gt-d1 gt-m1"},
+		{"update gt-keep", "--add-label=area:ui"},
+		{"comments add gt-keep", "[from gt-m1, gastown/polecats/Toast] repro attached"},
+		{"close gt-m1", "--reason=merged into gt-keep"},
+		{"close gt-m2", "--reason=merged into gt-keep"},
+	} {
+		if !hasCall(got, want...) {
+			t.Errorf("missing call %v in %v", want, got)
+		}
+	}
+	for _, unwanted := range [][]string{
+		{"dep add gt-keep gt-m1"},
+		{"dep add gt-keep gt-epic"},
+		{"dep add gt-m1.1 gt-keep"},
+		{"dep remove gt-m1.1"},
+		{"--add-label=gt:task"},
+	} {
+		if hasCall(got, unwanted...) {
+			t.Errorf("unexpected call %v in %v", unwanted, got)
+		}
+	}
+}
+
+func TestMergeIssuesIntoItself(t *testing.T) {
+	calls := installBDStub(t, `
+case "$cmd" in
+  show) printf '%s\n' '[{"id":"gt-keep"},{"id":"gt-m1"}]' ;;
+esac
+`)
+
+	if err := New(t.TempDir()).MergeIssues("gt-keep", "gt-m1", "gt-keep"); err == nil {
+		t.Fatal("expected error merging a bead into itself")
+	}
+	if hasCall(calls(), "close") {
+		t.Errorf("nothing should be closed: %v", calls())
+	}
+}
+
+func TestMergeIssuesMissing(t *testing.T) {
+	calls := installBDStub(t, `
+case "$cmd" in
+  show) printf '%s\n' '[{"id":"gt-keep"}]' ;;
+esac
+`)
+
+	if err := New(t.TempDir()).MergeIssues("gt-keep", "gt-gone"); err == nil {
+		t.Fatal("expected error merging a missing bead")
+	}
+	if hasCall(calls(), "close") {
+		t.Errorf("nothing should be closed when a bead is missing: %v", calls())
+	}
+}
@@ -0,0 +1,38 @@
+// Package beads provides schema migration status for beads databases.
+package beads
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// MigrationStatus reports the schema state of a beads database.
+type MigrationStatus struct {
+	CurrentVersion string `json:"current_version"`
+	LatestVersion  string `json:"latest_version"`
+	NeedsMigration bool   `json:"needs_migration"`
+}
+
+// MigrationStatus reports whether the database has pending migrations,
+// without running them. It parses `bd migrate --status --json`, and returns
+// ErrUnsupported if the installed bd cannot report migration status.
+func (b *Beads) MigrationStatus() (*MigrationStatus, error) {
+	if !b.SupportsFlag("migrate", "--status") {
+		return nil, ErrUnsupported
+	}
+	out, err := b.run("migrate", "--status", "--json")
+	if err != nil {
+		return nil, err
+	}
+
+	var status MigrationStatus
+	if err := json.Unmarshal(out, &status); err != nil {
+		return nil, fmt.Errorf("parsing bd migrate status: %w", err)
+	}
+
+	// Older bd versions omit needs_migration; derive it from the versions.
+	if !status.NeedsMigration && status.LatestVersion != "" && status.CurrentVersion != status.LatestVersion {
+		status.NeedsMigration = true
+	}
+	return &status, nil
+}
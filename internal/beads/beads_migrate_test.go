@@ -0,0 +1,68 @@
+package beads
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMigrationStatus(t *testing.T) {
+	tests := []struct {
+		name   string
+		output string
+		want   MigrationStatus
+	}{
+		{
+			name:   "up to date",
+			output: `{"current_version":"0.47","latest_version":"0.47","needs_migration":false}`,
+			want:   MigrationStatus{CurrentVersion: "0.47", LatestVersion: "0.47"},
+		},
+		{
+			name:   "pending",
+			output: `{"current_version":"0.45","latest_version":"0.47","needs_migration":true}`,
+			want:   MigrationStatus{CurrentVersion: "0.45", LatestVersion: "0.47", NeedsMigration: true},
+		},
+		{
+			name:   "derived from versions",
+			output: `{"current_version":"0.45","latest_version":"0.47"}`,
+			want:   MigrationStatus{CurrentVersion: "0.45", LatestVersion: "0.47", NeedsMigration: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := installBDStub(t, `
+case "$cmd $*" in
+  *--help*) echo "Usage: bd migrate [--status] [--json]" ;;
+  migrate*) printf '%s\n' '`+tt.output+`' ;;
+esac
+`)
+			got, err := New(t.TempDir()).MigrationStatus()
+			if err != nil {
+				t.Fatalf("MigrationStatus: %v", err)
+			}
+			if *got != tt.want {
+				t.Errorf("MigrationStatus() = %+v, want %+v", *got, tt.want)
+			}
+			if !hasCall(calls(), "migrate", "--status", "--json") {
+				t.Errorf("expected bd migrate --status --json, got %v", calls())
+			}
+		})
+	}
+}
+
+func TestMigrationStatusUnsupported(t *testing.T) {
+	// An older bd has migrate (for --update-repo-id) but no --status
+	calls := installBDStub(t, `
+case "$cmd $*" in
+  *--help*) echo "Usage: bd migrate [--update-repo-id]" ;;
+  migrate*) echo "unknown flag: --status" >&2; exit 1 ;;
+esac
+`)
+	_, err := New(t.TempDir()).MigrationStatus()
+	if !errors.Is(err, ErrUnsupported) {
+		t.Fatalf("MigrationStatus err = %v, want ErrUnsupported", err)
+	}
+	if hasCall(calls(), "migrate", "--status") {
+		t.Errorf("bd migrate --status ran on a bd without it: %v", calls())
+	}
+}
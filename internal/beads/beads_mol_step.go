@@ -0,0 +1,295 @@
+// Package beads provides step-by-step advancement through molecule steps.
+package beads
+
+import "fmt"
+
+// NextReadyStep returns the first open step of a molecule whose
+// dependencies are all closed. complete is true when every step is closed
+// (or the molecule has none). A nil step with complete false means the
+// remaining steps are in progress or blocked.
+//
+// Ready steps whose condition is not met are passed over as if skipped,
+// but nothing is closed; SkipUnmetSteps (or MolAdvance) records the skips.
+func (b *Beads) NextReadyStep(moleculeID string) (step *Issue, complete bool, err error) {
+	steps, err := b.moleculeSteps(moleculeID)
+	if err != nil {
+		return nil, false, err
+	}
+	step, complete, _, err = b.planSteps(steps)
+	return step, complete, err
+}
+
+// SkipUnmetSteps closes every step NextReadyStep would pass over because
+// its condition is not met, so the steps that depend on them can proceed.
+// Returns the IDs of the skipped steps.
+func (b *Beads) SkipUnmetSteps(moleculeID string) ([]string, error) {
+	steps, err := b.moleculeSteps(moleculeID)
+	if err != nil {
+		return nil, err
+	}
+	_, _, skip, err := b.planSteps(steps)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range skip {
+		if err := b.CloseWithReason("skipped: condition not met", id); err != nil {
+			return nil, fmt.Errorf("skipping step %s: %w", id, err)
+		}
+	}
+	return skip, nil
+}
+
+// MolAdvance closes a molecule's current step and returns the step to work
+// next, with the same results as NextReadyStep. The current step is the
+// first step in progress (or hooked); failing that, the next ready step.
+// Steps whose condition is not met are closed as skipped on the way.
+// Advancing a complete molecule is a no-op.
+func (b *Beads) MolAdvance(moleculeID string) (next *Issue, complete bool, err error) {
+	steps, err := b.moleculeSteps(moleculeID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var current *Issue
+	for _, s := range steps {
+		if s.Status == "in_progress" || s.Status == StatusHooked {
+			current = s
+			break
+		}
+	}
+	if current == nil {
+		if _, err := b.SkipUnmetSteps(moleculeID); err != nil {
+			return nil, false, err
+		}
+		ready, done, err := b.NextReadyStep(moleculeID)
+		if err != nil {
+			return nil, false, err
+		}
+		if done {
+			return nil, true, nil
+		}
+		if ready == nil {
+			return nil, false, fmt.Errorf("molecule %s has no current step: remaining steps are blocked", moleculeID)
+		}
+		current = ready
+	}
+
+	if err := b.Close(current.ID); err != nil {
+		return nil, false, fmt.Errorf("closing step %s: %w", current.ID, err)
+	}
+	if _, err := b.SkipUnmetSteps(moleculeID); err != nil {
+		return nil, false, err
+	}
+	return b.NextReadyStep(moleculeID)
+}
+
+// MolStep is one step of a molecule with its position in execution order.
+type MolStep struct {
+	ID     string `json:"id"`
+	Title  string `json:"title"`
+	Status string `json:"status"`
+	Number int    `json:"number"` // 1-based position in the molecule
+	Ready  bool   `json:"ready"`  // open with all dependencies closed
+}
+
+// MolSteps returns every step of a molecule in execution order: a step
+// always comes after the steps it depends on, and otherwise keeps bd's
+// listing order. Lets callers render "step 2 of 5" context.
+func (b *Beads) MolSteps(moleculeID string) ([]*MolStep, error) {
+	steps, err := b.moleculeSteps(moleculeID)
+	if err != nil {
+		return nil, err
+	}
+
+	closed := make(map[string]bool)
+	for _, s := range steps {
+		if s.Status == "closed" {
+			closed[s.ID] = true
+		}
+	}
+
+	ordered := orderSteps(steps)
+	result := make([]*MolStep, len(ordered))
+	for i, s := range ordered {
+		ready := s.Status == "open"
+		for _, dep := range s.DependsOn {
+			if !closed[dep] {
+				ready = false
+				break
+			}
+		}
+		result[i] = &MolStep{ID: s.ID, Title: s.Title, Status: s.Status, Number: i + 1, Ready: ready}
+	}
+	return result, nil
+}
+
+// orderSteps sorts steps so each follows its in-molecule dependencies,
+// keeping the input order where dependencies don't decide it. Steps caught
+// in a dependency cycle are appended in input order.
+func orderSteps(steps []*Issue) []*Issue {
+	inMol := make(map[string]bool, len(steps))
+	for _, s := range steps {
+		inMol[s.ID] = true
+	}
+
+	placed := make(map[string]bool, len(steps))
+	ordered := make([]*Issue, 0, len(steps))
+	for len(ordered) < len(steps) {
+		progress := false
+		for _, s := range steps {
+			if placed[s.ID] {
+				continue
+			}
+			ready := true
+			for _, dep := range s.DependsOn {
+				if inMol[dep] && !placed[dep] {
+					ready = false
+					break
+				}
+			}
+			if ready {
+				placed[s.ID] = true
+				ordered = append(ordered, s)
+				progress = true
+				break
+			}
+		}
+		if !progress {
+			for _, s := range steps {
+				if !placed[s.ID] {
+					placed[s.ID] = true
+					ordered = append(ordered, s)
+				}
+			}
+		}
+	}
+	return ordered
+}
+
+// planSteps works out the next ready step without changing anything. Ready
+// steps whose condition is not met are treated as closed (skipped) and
+// returned in skip, in the order they would be closed. Each pass skips a
+// different open step, so there are at most len(steps)+1 passes.
+func (b *Beads) planSteps(steps []*Issue) (ready *Issue, complete bool, skip []string, err error) {
+	view := make([]*Issue, len(steps))
+	for i, s := range steps {
+		c := *s
+		view[i] = &c
+	}
+
+	for pass := 0; pass <= len(view); pass++ {
+		step, done := nextReadyStep(view)
+		if step == nil {
+			return nil, done, skip, nil
+		}
+		met, err := b.conditionMet(step, view)
+		if err != nil {
+			return nil, false, nil, fmt.Errorf("step %s condition: %w", step.ID, err)
+		}
+		if met {
+			return step, false, skip, nil
+		}
+		step.Status = "closed"
+		skip = append(skip, step.ID)
+	}
+	return nil, false, nil, fmt.Errorf("molecule steps did not settle after %d passes", len(view)+1)
+}
+
+// conditionMet evaluates a step's condition. Refs name a sibling step (by
+// its "step:" ref or ID) or any other bead ID.
+func (b *Beads) conditionMet(step *Issue, steps []*Issue) (bool, error) {
+	expr := stepCondition(step)
+	if expr == "" {
+		return true, nil
+	}
+	cond, err := ParseCondition(expr)
+	if err != nil {
+		return false, err
+	}
+	return cond.Eval(func(ref string) (*Issue, error) {
+		for _, s := range steps {
+			if s.ID == ref || provenanceLine(s.Description, "step") == ref {
+				return s, nil
+			}
+		}
+		return b.Show(ref)
+	})
+}
+
+// moleculeSteps lists every step (child) of a molecule regardless of status.
+func (b *Beads) moleculeSteps(moleculeID string) ([]*Issue, error) {
+	steps, err := b.List(ListOptions{Parent: moleculeID, Status: "all", Priority: -1})
+	if err != nil {
+		return nil, fmt.Errorf("listing molecule steps: %w", err)
+	}
+	return steps, nil
+}
+
+// nextReadyStep picks the first open step whose dependencies are all closed.
+// Only "open" steps are candidates; in_progress steps are being worked.
+func nextReadyStep(steps []*Issue) (*Issue, bool) {
+	closed := make(map[string]bool)
+	var open []*Issue
+	complete := true
+	for _, s := range steps {
+		switch s.Status {
+		case "closed":
+			closed[s.ID] = true
+		case "open":
+			open = append(open, s)
+			complete = false
+		default:
+			complete = false
+		}
+	}
+	if complete {
+		return nil, true
+	}
+
+	for _, s := range open {
+		ready := true
+		for _, dep := range s.DependsOn {
+			if !closed[dep] {
+				ready = false
+				break
+			}
+		}
+		if ready {
+			return s, false
+		}
+	}
+	return nil, false
+}
+
+// MolComplete reports whether every step of a molecule is closed, counting
+// steps whose condition is not met as done. It changes nothing.
+func (b *Beads) MolComplete(moleculeID string) (bool, error) {
+	_, complete, err := b.NextReadyStep(moleculeID)
+	return complete, err
+}
+
+// MolFinalize closes a molecule's root (the guiding wisp) once all of its
+// steps are closed, so it is not left open after the work it guided is
+// done. Returns whether the root was closed by this call; an incomplete or
+// already-closed molecule is left as is. Remaining steps whose condition is
+// not met are closed as skipped before the root.
+func (b *Beads) MolFinalize(moleculeID string) (bool, error) {
+	complete, err := b.MolComplete(moleculeID)
+	if err != nil || !complete {
+		return false, err
+	}
+	if _, err := b.SkipUnmetSteps(moleculeID); err != nil {
+		return false, err
+	}
+	root, err := b.Show(moleculeID)
+	if err != nil {
+		return false, err
+	}
+	if root.Status == "closed" {
+		return false, nil
+	}
+	if err := b.CloseWithReason("molecule complete", moleculeID); err != nil {
+		return false, fmt.Errorf("closing molecule %s: %w", moleculeID, err)
+	}
+	return true, nil
+}
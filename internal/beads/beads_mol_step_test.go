@@ -0,0 +1,262 @@
+package beads
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+// molStepStub serves a 3-step linear molecule (gt-mol.1 <- .2 <- .3) whose
+// step statuses live in $BD_LOG.<step-id>; bd close marks a step closed.
+const molStepStub = `
+status() { cat "${BD_LOG}.$1" 2>/dev/null || echo open; }
+case "$cmd" in
+  list)
+    printf '[{"id":"gt-mol.1","status":"%s"},{"id":"gt-mol.2","status":"%s","depends_on":["gt-mol.1"]},{"id":"gt-mol.3","status":"%s","depends_on":["gt-mol.2"]}]\n' \
+      "$(status gt-mol.1)" "$(status gt-mol.2)" "$(status gt-mol.3)"
+    ;;
+  show) printf '[{"id":"%s","status":"%s"}]\n' "$1" "$(status "$1")" ;;
+  close) echo closed > "${BD_LOG}.$1" ;;
+esac
+`
+
+func TestMolAdvanceToCompletion(t *testing.T) {
+	calls := installBDStub(t, molStepStub)
+	b := New(t.TempDir())
+
+	step, complete, err := b.NextReadyStep("gt-mol")
+	if err != nil || complete || step == nil || step.ID != "gt-mol.1" {
+		t.Fatalf("NextReadyStep = %v, %v, %v; want gt-mol.1", step, complete, err)
+	}
+
+	for _, want := range []string{"gt-mol.2", "gt-mol.3", ""} {
+		next, complete, err := b.MolAdvance("gt-mol")
+		if err != nil {
+			t.Fatalf("MolAdvance: %v", err)
+		}
+		if want == "" {
+			if next != nil || !complete {
+				t.Errorf("after last step: next = %v, complete = %v; want nil, true", next, complete)
+			}
+			continue
+		}
+		if next == nil || next.ID != want || complete {
+			t.Errorf("MolAdvance = %v, complete=%v; want %s", next, complete, want)
+		}
+	}
+
+	for _, id := range []string{"gt-mol.1", "gt-mol.2", "gt-mol.3"} {
+		if !hasCall(calls(), "close "+id) {
+			t.Errorf("%s never closed: %v", id, calls())
+		}
+	}
+
+	// Advancing a finished molecule closes nothing more
+	before := len(calls())
+	if next, complete, err := b.MolAdvance("gt-mol"); err != nil || next != nil || !complete {
+		t.Errorf("MolAdvance on complete molecule = %v, %v, %v", next, complete, err)
+	}
+	for _, c := range calls()[before:] {
+		if hasCall([]string{c}, "close") {
+			t.Errorf("unexpected close after completion: %s", c)
+		}
+	}
+}
+
+func TestNextReadyStepPrefersInProgressAsCurrent(t *testing.T) {
+	steps := []*Issue{
+		{ID: "gt-mol.1", Status: "in_progress"},
+		{ID: "gt-mol.2", Status: "open", DependsOn: []string{"gt-mol.1"}},
+	}
+	if step, complete := nextReadyStep(steps); step != nil || complete {
+		t.Errorf("nextReadyStep = %v, %v; want nil, false while step 1 is in progress", step, complete)
+	}
+}
+
+func TestMolFinalizeWaitsForLastStep(t *testing.T) {
+	calls := installBDStub(t, molStepStub)
+	b := New(t.TempDir())
+
+	for i := 1; i <= 3; i++ {
+		closed, err := b.MolFinalize("gt-mol")
+		if err != nil {
+			t.Fatalf("MolFinalize: %v", err)
+		}
+		if closed || hasCall(calls(), "close gt-mol ") {
+			t.Fatalf("molecule root closed with %d of 3 steps done", i-1)
+		}
+		if _, _, err := b.MolAdvance("gt-mol"); err != nil {
+			t.Fatalf("MolAdvance: %v", err)
+		}
+	}
+
+	if complete, err := b.MolComplete("gt-mol"); err != nil || !complete {
+		t.Fatalf("MolComplete = %v, %v; want true", complete, err)
+	}
+	closed, err := b.MolFinalize("gt-mol")
+	if err != nil || !closed {
+		t.Fatalf("MolFinalize after last step = %v, %v; want closed", closed, err)
+	}
+	if !hasCall(calls(), "close gt-mol ", "--reason=molecule complete") {
+		t.Errorf("molecule root not closed: %v", calls())
+	}
+
+	// Already closed: nothing more to do
+	if closed, err := b.MolFinalize("gt-mol"); err != nil || closed {
+		t.Errorf("second MolFinalize = %v, %v; want no-op", closed, err)
+	}
+}
+
+func TestMolStepsOrder(t *testing.T) {
+	// Listed out of order: .3 depends on .2 depends on .1
+	installBDStub(t, `
+case "$cmd" in
+  list)
+    echo '[{"id":"gt-mol.3","title":"Ship","status":"open","depends_on":["gt-mol.2"]},{"id":"gt-mol.1","title":"Design","status":"closed"},{"id":"gt-mol.2","title":"Build","status":"open","depends_on":["gt-mol.1"]}]'
+    ;;
+esac
+`)
+	b := New(t.TempDir())
+
+	steps, err := b.MolSteps("gt-mol")
+	if err != nil {
+		t.Fatalf("MolSteps: %v", err)
+	}
+	want := []MolStep{
+		{ID: "gt-mol.1", Title: "Design", Status: "closed", Number: 1},
+		{ID: "gt-mol.2", Title: "Build", Status: "open", Number: 2, Ready: true},
+		{ID: "gt-mol.3", Title: "Ship", Status: "open", Number: 3},
+	}
+	if len(steps) != len(want) {
+		t.Fatalf("MolSteps returned %d steps, want %d", len(steps), len(want))
+	}
+	for i, w := range want {
+		if *steps[i] != w {
+			t.Errorf("step %d = %+v, want %+v", i, *steps[i], w)
+		}
+	}
+}
+
+// branchStub serves a molecule test -> fix -> ship where fix only runs while
+// bead gt-ci is open. Statuses live in $BD_LOG.<id>; bd close closes a bead.
+const branchStub = `
+status() { cat "${BD_LOG}.$1" 2>/dev/null || echo open; }
+case "$cmd" in
+  list)
+    printf '[{"id":"gt-mol.1","status":"%s","description":"instantiated_from: gt-proto\\nstep: test"},{"id":"gt-mol.2","status":"%s","description":"Fix it.\\n\\ninstantiated_from: gt-proto\\nstep: fix\\ncondition: gt-ci.status == open","depends_on":["gt-mol.1"]},{"id":"gt-mol.3","status":"%s","depends_on":["gt-mol.2"]}]\n' \
+      "$(status gt-mol.1)" "$(status gt-mol.2)" "$(status gt-mol.3)"
+    ;;
+  show) printf '[{"id":"%s","status":"%s"}]\n' "$1" "$(status "$1")" ;;
+  close) echo closed > "${BD_LOG}.$1" ;;
+esac
+`
+
+func TestMolAdvanceConditionalStep(t *testing.T) {
+	tests := []struct {
+		name     string
+		ciStatus string
+		wantNext string
+		skipped  bool
+	}{
+		{name: "condition holds", ciStatus: "open", wantNext: "gt-mol.2"},
+		{name: "condition fails", ciStatus: "closed", wantNext: "gt-mol.3", skipped: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := installBDStub(t, branchStub)
+			if err := os.WriteFile(os.Getenv("BD_LOG")+".gt-ci", []byte(tt.ciStatus+"\n"), 0644); err != nil {
+				t.Fatal(err)
+			}
+			b := New(t.TempDir())
+
+			next, complete, err := b.MolAdvance("gt-mol")
+			if err != nil || complete || next == nil || next.ID != tt.wantNext {
+				t.Fatalf("MolAdvance = %v, %v, %v; want %s", next, complete, err, tt.wantNext)
+			}
+			if got := hasCall(calls(), "close gt-mol.2 ", "--reason=skipped: condition not met"); got != tt.skipped {
+				t.Errorf("fix step skipped = %v, want %v: %v", got, tt.skipped, calls())
+			}
+		})
+	}
+}
+
+func TestNextReadyStepDoesNotSkip(t *testing.T) {
+	calls := installBDStub(t, branchStub)
+	if err := os.WriteFile(os.Getenv("BD_LOG")+".gt-mol.1", []byte("closed\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(os.Getenv("BD_LOG")+".gt-ci", []byte("closed\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	b := New(t.TempDir())
+
+	// The fix step's condition fails, so the ship step is next, but only
+	// SkipUnmetSteps may close the fix step.
+	step, complete, err := b.NextReadyStep("gt-mol")
+	if err != nil || complete || step == nil || step.ID != "gt-mol.3" {
+		t.Fatalf("NextReadyStep = %v, %v, %v; want gt-mol.3", step, complete, err)
+	}
+	if _, err := b.MolComplete("gt-mol"); err != nil {
+		t.Fatalf("MolComplete: %v", err)
+	}
+	if hasCall(calls(), "close") {
+		t.Fatalf("read-only queries closed a step: %v", calls())
+	}
+
+	skipped, err := b.SkipUnmetSteps("gt-mol")
+	if err != nil || len(skipped) != 1 || skipped[0] != "gt-mol.2" {
+		t.Fatalf("SkipUnmetSteps = %v, %v; want [gt-mol.2]", skipped, err)
+	}
+	if !hasCall(calls(), "close gt-mol.2 ", "--reason=skipped: condition not met") {
+		t.Errorf("fix step not skipped: %v", calls())
+	}
+}
+
+func TestStepConditionIgnoresInstructions(t *testing.T) {
+	tests := []struct {
+		name string
+		desc string
+		want string
+	}{
+		{"provenance", "Run it.\n\ninstantiated_from: gt-proto\nstep: fix\ncondition: ci.status == open", "ci.status == open"},
+		{"prose only", "Check this first.\ncondition: anything goes\n\ninstantiated_from: gt-proto\nstep: fix", ""},
+		{"no provenance", "condition: ci.status == open", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := stepCondition(&Issue{Description: tt.desc}); got != tt.want {
+				t.Errorf("stepCondition = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseCondition(t *testing.T) {
+	cond, err := ParseCondition("test.label == failed && gt-ci.status != closed")
+	if err != nil {
+		t.Fatalf("ParseCondition: %v", err)
+	}
+	if got := strings.Join(cond.Refs(), ","); got != "test,gt-ci" {
+		t.Errorf("Refs = %s, want test,gt-ci", got)
+	}
+
+	issues := map[string]*Issue{
+		"test":  {ID: "gt-mol.1", Labels: []string{"failed"}},
+		"gt-ci": {ID: "gt-ci", Status: "open"},
+	}
+	resolve := func(ref string) (*Issue, error) { return issues[ref], nil }
+	if ok, err := cond.Eval(resolve); err != nil || !ok {
+		t.Errorf("Eval = %v, %v; want true", ok, err)
+	}
+	issues["gt-ci"].Status = "closed"
+	if ok, err := cond.Eval(resolve); err != nil || ok {
+		t.Errorf("Eval after close = %v, %v; want false", ok, err)
+	}
+
+	for _, bad := range []string{"", "test.status", "test.title == x", "test.status ~= open", "status == open"} {
+		if _, err := ParseCondition(bad); err == nil {
+			t.Errorf("ParseCondition(%q) succeeded, want error", bad)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+// Package beads provides recovery of work stuck on dead agents' hooks.
+package beads
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ReapStuckHooks releases hooked beads whose assigned agent never picked
+// them up: not updated within threshold and, when agentLive is non-nil, with
+// no live session. The beads layer cannot see sessions, so callers that can
+// (e.g. a deacon patrol checking tmux) pass agentLive; when nil, only
+// staleness decides. Released beads go back to open and unassigned so they can
+// be slung again. Returns the released IDs; beads that fail to release are
+// reported in the returned error and the rest are still released.
+func (b *Beads) ReapStuckHooks(threshold time.Duration, agentLive func(agentID string) bool) (released []string, err error) {
+	hooked, err := b.List(ListOptions{Status: StatusHooked, Priority: -1})
+	if err != nil {
+		return nil, fmt.Errorf("listing hooked beads: %w", err)
+	}
+
+	cutoff := now().Add(-threshold)
+	reason := fmt.Sprintf("stuck in hooked for over %s", threshold)
+	var failed []string
+	for _, issue := range hooked {
+		updated, err := time.Parse(time.RFC3339, issue.UpdatedAt)
+		if err != nil || updated.After(cutoff) {
+			continue
+		}
+		if agentLive != nil && issue.Assignee != "" && agentLive(issue.Assignee) {
+			continue
+		}
+		if err := b.ReleaseWithReason(issue.ID, reason); err != nil {
+			failed = append(failed, fmt.Sprintf("%s: %v", issue.ID, err))
+			continue
+		}
+		released = append(released, issue.ID)
+	}
+
+	if len(failed) > 0 {
+		return released, fmt.Errorf("releasing stuck hooks: %s", strings.Join(failed, "; "))
+	}
+	return released, nil
+}
@@ -0,0 +1,44 @@
+package beads
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestReapStuckHooks(t *testing.T) {
+	calls := installBDStub(t, `
+case "$cmd" in
+  list)
+    cat <<'JSON'
+[
+ {"id":"gt-fresh","status":"hooked","assignee":"gastown/polecats/ace","updated_at":"2026-01-01T11:55:00Z"},
+ {"id":"gt-stale","status":"hooked","assignee":"gastown/polecats/bee","updated_at":"2026-01-01T10:00:00Z"},
+ {"id":"gt-busy","status":"hooked","assignee":"gastown/polecats/cat","updated_at":"2026-01-01T10:00:00Z"}
+]
+JSON
+    ;;
+esac
+`)
+	origNow := now
+	defer func() { now = origNow }()
+	now = func() time.Time { return time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC) }
+	live := func(agentID string) bool { return agentID == "gastown/polecats/cat" }
+
+	released, err := New(t.TempDir()).ReapStuckHooks(30*time.Minute, live)
+	if err != nil {
+		t.Fatalf("ReapStuckHooks: %v", err)
+	}
+	if got := strings.Join(released, ","); got != "gt-stale" {
+		t.Errorf("released = %s, want gt-stale", got)
+	}
+	if !hasCall(calls(), "list ", "--status=hooked") {
+		t.Errorf("hooked beads not listed: %v", calls())
+	}
+	if !hasCall(calls(), "update gt-stale ", "--status=open", "--assignee=") {
+		t.Errorf("stale bead not released: %v", calls())
+	}
+	if hasCall(calls(), "update gt-fresh ") || hasCall(calls(), "update gt-busy ") {
+		t.Errorf("fresh or live bead released: %v", calls())
+	}
+}
@@ -2,6 +2,7 @@
 package beads
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -62,43 +63,136 @@ func ResolveBeadsDir(workDir string) string {
 		return beadsDir
 	}
 
-	// Follow redirect chains (e.g., crew/.beads -> rig/.beads -> mayor/rig/.beads)
+	// Follow redirect chains (e.g., polecat/.beads -> rig/.beads -> mayor/rig/.beads).
 	// This is intentional for the rig-level redirect architecture.
-	// Limit depth to prevent infinite loops from misconfigured redirects.
-	return resolveBeadsDirWithDepth(resolved, 3)
+	final, err := followBeadsRedirects(resolved, beadsDir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: %v, using %s\n", err, beadsDir)
+		return beadsDir
+	}
+	return final
 }
 
-// resolveBeadsDirWithDepth follows redirect chains with a depth limit.
-func resolveBeadsDirWithDepth(beadsDir string, maxDepth int) string {
-	if maxDepth <= 0 {
-		fmt.Fprintf(os.Stderr, "Warning: redirect chain too deep at %s, stopping\n", beadsDir)
-		return beadsDir
+// maxRedirectDepth bounds how many redirect hops are followed. Real layouts
+// use at most three (worktree -> rig -> mayor/rig); the limit is a backstop.
+const maxRedirectDepth = 10
+
+// ErrRedirectCycle is returned when a chain of beads redirects loops back on itself.
+var ErrRedirectCycle = errors.New("circular beads redirect")
+
+// ResolveBeadsDirStrict is like ResolveBeadsDir but reports broken redirect
+// chains instead of falling back. It returns an error wrapping
+// ErrRedirectCycle if the chain revisits a directory, and an error if it
+// exceeds maxRedirectDepth hops. It never modifies redirect files.
+func ResolveBeadsDirStrict(workDir string) (string, error) {
+	if filepath.Base(workDir) == ".beads" {
+		workDir = filepath.Dir(workDir)
 	}
+	return followBeadsRedirects(filepath.Join(workDir, ".beads"))
+}
 
-	redirectPath := filepath.Join(beadsDir, "redirect")
-	data, err := os.ReadFile(redirectPath) //nolint:gosec // G304: path is constructed internally
-	if err != nil {
-		// No redirect, this is the final destination
-		return beadsDir
+// followBeadsRedirects walks the redirect chain starting at beadsDir and
+// returns the final .beads directory. Directories in seen count as already
+// visited, so a chain leading back to any of them is reported as a cycle.
+func followBeadsRedirects(beadsDir string, seen ...string) (string, error) {
+	visited := make(map[string]bool, len(seen)+1)
+	chain := append([]string{}, seen...)
+	for _, dir := range seen {
+		visited[dir] = true
 	}
 
+	current := beadsDir
+	for depth := 0; ; depth++ {
+		if visited[current] {
+			chain = append(chain, current)
+			return "", fmt.Errorf("%w: %s", ErrRedirectCycle, strings.Join(chain, " -> "))
+		}
+		visited[current] = true
+		chain = append(chain, current)
+
+		next, ok := readRedirect(current)
+		if !ok {
+			return current, nil
+		}
+		if depth >= maxRedirectDepth {
+			return "", fmt.Errorf("redirect chain deeper than %d at %s", maxRedirectDepth, current)
+		}
+		current = next
+	}
+}
+
+// readRedirect returns the directory named by beadsDir/redirect, resolved
+// relative to the parent of beadsDir. ok is false if there is no redirect.
+func readRedirect(beadsDir string) (target string, ok bool) {
+	data, err := os.ReadFile(filepath.Join(beadsDir, "redirect")) //nolint:gosec // G304: path is constructed internally
+	if err != nil {
+		return "", false
+	}
 	redirectTarget := strings.TrimSpace(string(data))
 	if redirectTarget == "" {
-		return beadsDir
+		return "", false
 	}
+	return filepath.Clean(filepath.Join(filepath.Dir(beadsDir), redirectTarget)), true
+}
 
-	// Resolve relative to parent of beadsDir (the workDir)
-	workDir := filepath.Dir(beadsDir)
-	resolved := filepath.Clean(filepath.Join(workDir, redirectTarget))
+// RepoKind classifies what a directory's .beads entry is.
+type RepoKind int
 
-	// Detect circular redirect
-	if resolved == beadsDir {
-		fmt.Fprintf(os.Stderr, "Warning: circular redirect detected in %s, stopping\n", redirectPath)
-		return beadsDir
+const (
+	// RepoKindNone means there is no .beads directory.
+	RepoKindNone RepoKind = iota
+	// RepoKindReal means .beads is a standalone beads repository.
+	RepoKindReal
+	// RepoKindRedirect means .beads only redirects to a repository elsewhere,
+	// as in crew and polecat worktrees.
+	RepoKindRedirect
+)
+
+// String returns the kind's name.
+func (k RepoKind) String() string {
+	switch k {
+	case RepoKindReal:
+		return "real"
+	case RepoKindRedirect:
+		return "redirect"
+	default:
+		return "none"
+	}
+}
+
+// BeadsRepoKind reports whether workDir holds a real beads repository, a
+// redirect to one, or neither. Redirects are followed to confirm the target
+// exists; a dangling or circular redirect is reported as RepoKindRedirect
+// with an error.
+func BeadsRepoKind(workDir string) (RepoKind, error) {
+	if filepath.Base(workDir) == ".beads" {
+		workDir = filepath.Dir(workDir)
+	}
+	beadsDir := filepath.Join(workDir, ".beads")
+
+	info, err := os.Stat(beadsDir)
+	if os.IsNotExist(err) {
+		return RepoKindNone, nil
+	}
+	if err != nil {
+		return RepoKindNone, err
+	}
+	if !info.IsDir() {
+		return RepoKindNone, nil
 	}
 
-	// Recursively follow
-	return resolveBeadsDirWithDepth(resolved, maxDepth-1)
+	if _, ok := readRedirect(beadsDir); !ok {
+		return RepoKindReal, nil
+	}
+
+	target, err := ResolveBeadsDirStrict(workDir)
+	if err != nil {
+		return RepoKindRedirect, err
+	}
+	if info, err := os.Stat(target); err != nil || !info.IsDir() {
+		return RepoKindRedirect, fmt.Errorf("dangling redirect in %s: %s does not exist", beadsDir, target)
+	}
+	return RepoKindRedirect, nil
 }
 
 // cleanBeadsRuntimeFiles removes gitignored runtime files from a .beads directory
@@ -0,0 +1,63 @@
+// Package beads provides reopen tracking for beads that bounce back open.
+package beads
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// reopensLabelPrefix prefixes the label counting how often an issue was
+// reopened. The full label is "reopens:<n>". bd has no counter column, so
+// the count rides on a label like the TTL expiry does.
+const reopensLabelPrefix = "reopens:"
+
+// ReopenCount returns how many times an issue was reopened through
+// ReopenWithReason. Issues without a reopens label have a count of zero.
+func ReopenCount(issue *Issue) int {
+	if issue == nil {
+		return 0
+	}
+	for _, label := range issue.Labels {
+		if !strings.HasPrefix(label, reopensLabelPrefix) {
+			continue
+		}
+		n, err := strconv.Atoi(strings.TrimPrefix(label, reopensLabelPrefix))
+		if err != nil {
+			return 0
+		}
+		return n
+	}
+	return 0
+}
+
+// ReopenWithReason reopens a closed issue, recording the reason with bd and
+// bumping the issue's reopen count. A high count flags work that keeps
+// ping-ponging between done and not done.
+func (b *Beads) ReopenWithReason(id, reason string) error {
+	issue, err := b.Show(id)
+	if err != nil {
+		return err
+	}
+
+	args := []string{"reopen", id}
+	if reason != "" {
+		args = append(args, "--reason="+reason)
+	}
+	if _, err := b.run(args...); err != nil {
+		return err
+	}
+	b.logTransition(id, "open", nil)
+
+	count := ReopenCount(issue)
+	opts := UpdateOptions{AddLabels: []string{reopensLabelPrefix + strconv.Itoa(count+1)}}
+	for _, label := range issue.Labels {
+		if strings.HasPrefix(label, reopensLabelPrefix) {
+			opts.RemoveLabels = append(opts.RemoveLabels, label)
+		}
+	}
+	if err := b.Update(id, opts); err != nil {
+		return fmt.Errorf("recording reopen count: %w", err)
+	}
+	return nil
+}
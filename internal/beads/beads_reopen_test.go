@@ -0,0 +1,64 @@
+package beads
+
+import "testing"
+
+// reopenStub keeps the issue's labels in a file next to the bd log so
+// show reflects earlier label updates.
+const reopenStub = `
+labels="${BD_LOG}.labels"
+case "$cmd" in
+  show)
+    printf '[{"id":"gt-abc","status":"closed","labels":[%s]}]\n' "$(cat "$labels" 2>/dev/null)"
+    ;;
+  update)
+    for arg in "$@"; do
+      case "$arg" in
+        --add-label=*) printf '"%s"' "${arg#--add-label=}" > "$labels" ;;
+      esac
+    done
+    ;;
+esac
+`
+
+func TestReopenWithReason(t *testing.T) {
+	calls := installBDStub(t, reopenStub)
+	b := New(t.TempDir())
+
+	for i := 0; i < 2; i++ {
+		if err := b.ReopenWithReason("gt-abc", "tests still failing"); err != nil {
+			t.Fatalf("ReopenWithReason #%d: %v", i+1, err)
+		}
+	}
+
+	issue, err := b.Show("gt-abc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := ReopenCount(issue); got != 2 {
+		t.Errorf("ReopenCount = %d, want 2 (labels %v)", got, issue.Labels)
+	}
+	if !hasCall(calls(), "reopen gt-abc", "--reason=tests still failing") {
+		t.Errorf("reason not passed to bd reopen: %v", calls())
+	}
+	if !hasCall(calls(), "update gt-abc", "--remove-label=reopens:1", "--add-label=reopens:2") {
+		t.Errorf("old count label not replaced: %v", calls())
+	}
+}
+
+func TestReopenCount(t *testing.T) {
+	tests := []struct {
+		name   string
+		issue  *Issue
+		expect int
+	}{
+		{"nil", nil, 0},
+		{"never reopened", &Issue{Labels: []string{"gt:task"}}, 0},
+		{"reopened", &Issue{Labels: []string{"gt:task", "reopens:3"}}, 3},
+		{"garbled", &Issue{Labels: []string{"reopens:lots"}}, 0},
+	}
+	for _, tt := range tests {
+		if got := ReopenCount(tt.issue); got != tt.expect {
+			t.Errorf("%s: ReopenCount = %d, want %d", tt.name, got, tt.expect)
+		}
+	}
+}
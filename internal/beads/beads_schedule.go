@@ -0,0 +1,117 @@
+// Package beads provides scheduled dispatch for beads slung with --at.
+package beads
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// LabelScheduled marks an open bead waiting for a scheduled sling.
+const LabelScheduled = "gt:scheduled"
+
+// notBeforeLabelPrefix prefixes the label carrying a scheduled bead's start
+// time. The full label is "not-before:<RFC3339 timestamp>".
+const notBeforeLabelPrefix = "not-before:"
+
+// notBeforeLabel formats the start-time label for the given time.
+func notBeforeLabel(at time.Time) string {
+	return notBeforeLabelPrefix + at.UTC().Format(time.RFC3339)
+}
+
+// NotBefore returns the start time recorded on a scheduled bead. The second
+// return value is false if the bead carries no start label or the label
+// cannot be parsed.
+func NotBefore(issue *Issue) (time.Time, bool) {
+	if issue == nil {
+		return time.Time{}, false
+	}
+	for _, label := range issue.Labels {
+		if !strings.HasPrefix(label, notBeforeLabelPrefix) {
+			continue
+		}
+		at, err := time.Parse(time.RFC3339, strings.TrimPrefix(label, notBeforeLabelPrefix))
+		if err != nil {
+			return time.Time{}, false
+		}
+		return at, true
+	}
+	return time.Time{}, false
+}
+
+// IsDue reports whether a scheduled bead may be dispatched as of the given
+// time. Beads without a start label are never due.
+func IsDue(issue *Issue, at time.Time) bool {
+	start, ok := NotBefore(issue)
+	return ok && !at.Before(start)
+}
+
+// notBeforeLabels returns the start-time labels on an issue.
+func notBeforeLabels(issue *Issue) []string {
+	var labels []string
+	for _, label := range issue.Labels {
+		if strings.HasPrefix(label, notBeforeLabelPrefix) {
+			labels = append(labels, label)
+		}
+	}
+	return labels
+}
+
+// Schedule records that a bead should be slung to target no earlier than
+// notBefore. Rescheduling replaces the previous start time and target.
+func (b *Beads) Schedule(id, target string, notBefore time.Time) error {
+	issue, err := b.Show(id)
+	if err != nil {
+		return fmt.Errorf("fetching bead: %w", err)
+	}
+	if err := b.updateAttachmentFields(id, func(f *AttachmentFields) {
+		f.ScheduledTarget = target
+	}); err != nil {
+		return err
+	}
+	return b.Update(id, UpdateOptions{
+		AddLabels:    []string{LabelScheduled, notBeforeLabel(notBefore)},
+		RemoveLabels: notBeforeLabels(issue),
+	})
+}
+
+// Unschedule removes a bead's schedule: the scheduled label, its start time
+// and the recorded target.
+func (b *Beads) Unschedule(issue *Issue) error {
+	if err := b.updateAttachmentFields(issue.ID, func(f *AttachmentFields) {
+		f.ScheduledTarget = ""
+	}); err != nil {
+		return err
+	}
+	return b.Update(issue.ID, UpdateOptions{
+		RemoveLabels: append([]string{LabelScheduled}, notBeforeLabels(issue)...),
+	})
+}
+
+// DueScheduled returns open scheduled beads whose start time has passed,
+// earliest first. Beads that are not yet due are left alone.
+func (b *Beads) DueScheduled() ([]*Issue, error) {
+	issues, err := b.List(ListOptions{
+		Status:   "open",
+		Label:    LabelScheduled,
+		Priority: -1,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing scheduled beads: %w", err)
+	}
+
+	current := now()
+	var due []*Issue
+	for _, issue := range issues {
+		if IsDue(issue, current) {
+			due = append(due, issue)
+		}
+	}
+	sort.SliceStable(due, func(i, j int) bool {
+		a, _ := NotBefore(due[i])
+		c, _ := NotBefore(due[j])
+		return a.Before(c)
+	})
+	return due, nil
+}
@@ -0,0 +1,53 @@
+package beads
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDueScheduled(t *testing.T) {
+	installBDStub(t, `
+case "$cmd" in
+  list)
+    cat <<'JSON'
+[
+ {"id":"gt-later","status":"open","labels":["gt:scheduled","not-before:2026-01-01T18:00:00Z"]},
+ {"id":"gt-second","status":"open","labels":["gt:scheduled","not-before:2026-01-01T11:00:00Z"]},
+ {"id":"gt-first","status":"open","labels":["gt:scheduled","not-before:2026-01-01T09:00:00Z"]},
+ {"id":"gt-broken","status":"open","labels":["gt:scheduled","not-before:tonight"]}
+]
+JSON
+    ;;
+esac
+`)
+	clock := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	origNow := now
+	now = func() time.Time { return clock }
+	defer func() { now = origNow }()
+
+	b := New(t.TempDir())
+	due, err := b.DueScheduled()
+	if err != nil {
+		t.Fatalf("DueScheduled: %v", err)
+	}
+	if len(due) != 2 || due[0].ID != "gt-first" || due[1].ID != "gt-second" {
+		t.Fatalf("due = %v, want [gt-first gt-second]", issueIDs(due))
+	}
+
+	clock = time.Date(2026, 1, 1, 18, 0, 0, 0, time.UTC)
+	due, err = b.DueScheduled()
+	if err != nil {
+		t.Fatalf("DueScheduled: %v", err)
+	}
+	if len(due) != 3 || due[2].ID != "gt-later" {
+		t.Errorf("due at start time = %v, want gt-later included", issueIDs(due))
+	}
+}
+
+func issueIDs(issues []*Issue) []string {
+	ids := make([]string, 0, len(issues))
+	for _, issue := range issues {
+		ids = append(ids, issue.ID)
+	}
+	return ids
+}
@@ -0,0 +1,226 @@
+// Package beads provides text search within one database or across a town.
+package beads
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// SearchOptions narrows a text search.
+type SearchOptions struct {
+	Status string // "open", "closed", "all"; empty uses bd's default
+	Label  string // Only issues with this label
+	Limit  int    // Max results after ranking; 0 means up to ListCap
+}
+
+// Search returns issues whose text matches query, using bd search, most
+// relevant first (see searchRank), ties broken by ID. bd is asked for up to
+// ListCap matches and opts.Limit is applied after ranking, so the best
+// matches are not cut off by bd's own ordering.
+func (b *Beads) Search(query string, opts SearchOptions) ([]*Issue, error) {
+	args := []string{"search", query, "--json"}
+	if opts.Status != "" {
+		args = append(args, "--status="+opts.Status)
+	}
+	if opts.Label != "" {
+		args = append(args, "--label="+opts.Label)
+	}
+	args = append(args, fmt.Sprintf("--limit=%d", ListCap))
+
+	out, err := b.run(args...)
+	if err != nil {
+		return nil, err
+	}
+
+	var issues []*Issue
+	if err := json.Unmarshal(out, &issues); err != nil {
+		return nil, fmt.Errorf("parsing bd search output: %w", err)
+	}
+	sort.SliceStable(issues, func(i, j int) bool {
+		ri, rj := searchRank(issues[i], query), searchRank(issues[j], query)
+		if ri != rj {
+			return ri < rj
+		}
+		return issues[i].ID < issues[j].ID
+	})
+	if opts.Limit > 0 && len(issues) > opts.Limit {
+		issues = issues[:opts.Limit]
+	}
+	return issues, nil
+}
+
+// SearchHit is a search result with the text that matched, for display.
+type SearchHit struct {
+	Issue   *Issue
+	Field   string // "title" or "description"; empty if neither contains the query
+	Snippet string // The matched text with surrounding context
+}
+
+// snippetContext is how many runes of context a snippet keeps on each side
+// of the match.
+const snippetContext = 40
+
+// SearchWithSnippets runs Search and pairs each issue with the field that
+// matched and a snippet around the first match in it.
+func (b *Beads) SearchWithSnippets(query string, opts SearchOptions) ([]SearchHit, error) {
+	issues, err := b.Search(query, opts)
+	if err != nil {
+		return nil, err
+	}
+	hits := make([]SearchHit, 0, len(issues))
+	for _, issue := range issues {
+		hit := SearchHit{Issue: issue}
+		for _, f := range []struct{ name, text string }{
+			{"title", issue.Title},
+			{"description", issue.Description},
+		} {
+			if snippet, ok := extractSnippet(f.text, query, snippetContext); ok {
+				hit.Field, hit.Snippet = f.name, snippet
+				break
+			}
+		}
+		hits = append(hits, hit)
+	}
+	return hits, nil
+}
+
+// extractSnippet returns the first case-insensitive match of query in text
+// with up to context runes on each side, whitespace collapsed and "…" marking
+// clipped ends. It works in runes so multibyte text is never split. ok is
+// false if text does not contain query.
+func extractSnippet(text, query string, context int) (snippet string, ok bool) {
+	runes := []rune(text)
+	q := []rune(strings.ToLower(query))
+	if len(q) == 0 {
+		return "", false
+	}
+	start := -1
+	for i := 0; i+len(q) <= len(runes) && start < 0; i++ {
+		start = i
+		for j, r := range q {
+			if unicode.ToLower(runes[i+j]) != r {
+				start = -1
+				break
+			}
+		}
+	}
+	if start < 0 {
+		return "", false
+	}
+
+	from, to := max(start-context, 0), min(start+len(q)+context, len(runes))
+	snippet = strings.Join(strings.Fields(string(runes[from:to])), " ")
+	if from > 0 {
+		snippet = "…" + snippet
+	}
+	if to < len(runes) {
+		snippet += "…"
+	}
+	return snippet, true
+}
+
+// SearchAllRigs runs Search against every database in the town's
+// routes.jsonl (the receiver's workDir is the town root), for finding a bead
+// without knowing which rig owns it. Results are tagged with their rig and
+// merged by relevance (see searchRank), route order breaking ties; opts.Limit
+// caps the merged list. If some rigs fail, the rest are still returned
+// along with a RigErrors.
+func (b *Beads) SearchAllRigs(query string, opts SearchOptions) ([]*Issue, error) {
+	paths, err := b.townRoutePaths()
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([][]*Issue, len(paths))
+	err = forEachRig(paths, 0, func(i int, path string) error {
+		issues, err := New(filepath.Join(b.workDir, path)).Search(query, opts)
+		if err != nil {
+			return err
+		}
+		rig := routeRigName(path)
+		for _, issue := range issues {
+			issue.Rig = rig
+		}
+		results[i] = issues
+		return nil
+	})
+
+	var all []*Issue
+	for _, issues := range results {
+		all = append(all, issues...)
+	}
+	sort.SliceStable(all, func(i, j int) bool {
+		return searchRank(all[i], query) < searchRank(all[j], query)
+	})
+	if opts.Limit > 0 && len(all) > opts.Limit {
+		all = all[:opts.Limit]
+	}
+	return all, err
+}
+
+// searchRank orders search hits, lower first: an exact ID match, then a
+// whole-word title match, a partial title match, a whole-word description
+// match, a partial description match, and last any other match bd found.
+func searchRank(issue *Issue, query string) int {
+	if strings.EqualFold(issue.ID, query) {
+		return 0
+	}
+	switch matchQuality(issue.Title, query) {
+	case matchWord:
+		return 1
+	case matchPartial:
+		return 2
+	}
+	switch matchQuality(issue.Description, query) {
+	case matchWord:
+		return 3
+	case matchPartial:
+		return 4
+	}
+	return 5
+}
+
+// Match qualities returned by matchQuality.
+const (
+	matchNone = iota
+	matchPartial
+	matchWord
+)
+
+// matchQuality reports how query occurs in text, ignoring case: as a whole
+// word (not touching a letter or digit on either side), only inside a
+// longer word, or not at all.
+func matchQuality(text, query string) int {
+	text, query = strings.ToLower(text), strings.ToLower(query)
+	if query == "" {
+		return matchNone
+	}
+	quality := matchNone
+	for from := 0; ; {
+		i := strings.Index(text[from:], query)
+		if i < 0 {
+			return quality
+		}
+		start := from + i
+		end := start + len(query)
+		before, _ := utf8.DecodeLastRuneInString(text[:start])
+		after, _ := utf8.DecodeRuneInString(text[end:])
+		if !isWordRune(before) && !isWordRune(after) {
+			return matchWord
+		}
+		quality = matchPartial
+		_, size := utf8.DecodeRuneInString(text[start:])
+		from = start + size
+	}
+}
+
+// isWordRune reports whether r is part of a word. The utf8.RuneError
+// returned at either end of a string is not.
+func isWordRune(r rune) bool {
+	return r != utf8.RuneError && (unicode.IsLetter(r) || unicode.IsDigit(r))
+}
@@ -0,0 +1,159 @@
+package beads
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSearchAllRigs(t *testing.T) {
+	calls := installBDStub(t, `
+case "$cmd" in
+  search)
+    case "$BEADS_DIR" in
+      */gastown/mayor/rig/.beads) printf '%s\n' '[{"id":"gt-1","title":"Notes","description":"the widget is slow"},{"id":"gt-2","title":"Widget crash"}]' ;;
+      */beads/mayor/rig/.beads) printf '%s\n' '[{"id":"bd-9","title":"Widget sync"}]' ;;
+      *) echo '[]' ;;
+    esac
+    ;;
+esac
+`)
+	townRoot := setupTownRoutes(t, []Route{
+		{Prefix: "hq-", Path: "."},
+		{Prefix: "gt-", Path: "gastown/mayor/rig"},
+		{Prefix: "bd-", Path: "beads/mayor/rig"},
+	})
+
+	issues, err := New(townRoot).SearchAllRigs("widget", SearchOptions{Status: "open"})
+	if err != nil {
+		t.Fatalf("SearchAllRigs: %v", err)
+	}
+
+	var got []string
+	for _, issue := range issues {
+		got = append(got, issue.ID+"@"+issue.Rig)
+	}
+	// Title matches first (route order), then the description-only match
+	if want := "gt-2@gastown,bd-9@beads,gt-1@gastown"; strings.Join(got, ",") != want {
+		t.Errorf("SearchAllRigs() = %s, want %s", strings.Join(got, ","), want)
+	}
+	if !hasCall(calls(), "search widget --json --status=open") {
+		t.Errorf("search options not passed to bd: %v", calls())
+	}
+
+	// A bead found by ID ranks first, whichever rig holds it
+	issues, err = New(townRoot).SearchAllRigs("BD-9", SearchOptions{Limit: 1})
+	if err != nil {
+		t.Fatalf("SearchAllRigs: %v", err)
+	}
+	if len(issues) != 1 || issues[0].ID != "bd-9" || issues[0].Rig != "beads" {
+		t.Errorf("SearchAllRigs(BD-9, limit 1) = %v, want bd-9 from beads", issueIDs(issues))
+	}
+}
+
+func TestSearchRanksByRelevance(t *testing.T) {
+	calls := installBDStub(t, `
+case "$cmd" in
+  search)
+    printf '%s\n' '[{"id":"gt-5","title":"Notes","description":"widgets everywhere"},{"id":"gt-4","title":"Notes","description":"the widget is slow"},{"id":"gt-3","title":"Widgets page"},{"id":"gt-2","title":"Fix widget crash"},{"id":"gt-1","title":"Widget sync"}]'
+    ;;
+esac
+`)
+
+	issues, err := New(t.TempDir()).Search("widget", SearchOptions{})
+	if err != nil {
+		t.Fatalf("Search: %v", err)
+	}
+	// Whole-word title hits (by ID), partial title hit, then description hits
+	want := "gt-1,gt-2,gt-3,gt-4,gt-5"
+	if got := strings.Join(issueIDs(issues), ","); got != want {
+		t.Errorf("Search() = %s, want %s", got, want)
+	}
+
+	// The limit keeps the best matches, not bd's first rows
+	issues, err = New(t.TempDir()).Search("widget", SearchOptions{Limit: 2})
+	if err != nil {
+		t.Fatalf("Search: %v", err)
+	}
+	if got := strings.Join(issueIDs(issues), ","); got != "gt-1,gt-2" {
+		t.Errorf("Search(limit 2) = %s, want gt-1,gt-2", got)
+	}
+	if hasCall(calls(), "--limit=2") {
+		t.Errorf("limit passed to bd before ranking: %v", calls())
+	}
+}
+
+func TestMatchQuality(t *testing.T) {
+	tests := []struct {
+		text, query string
+		want        int
+	}{
+		{"Widget crash", "widget", matchWord},
+		{"fix the widget.", "WIDGET", matchWord},
+		{"widgets", "widget", matchPartial},
+		{"widgets and a widget", "widget", matchWord},
+		{"größe widget", "widget", matchWord},
+		{"éwidget", "widget", matchPartial},
+		{"gadget", "widget", matchNone},
+		{"widget", "", matchNone},
+	}
+	for _, tt := range tests {
+		if got := matchQuality(tt.text, tt.query); got != tt.want {
+			t.Errorf("matchQuality(%q, %q) = %d, want %d", tt.text, tt.query, got, tt.want)
+		}
+	}
+}
+
+func TestSearchWithSnippets(t *testing.T) {
+	installBDStub(t, `
+case "$cmd" in
+  search)
+    printf '%s\n' '[{"id":"gt-1","title":"Widget sync"},{"id":"gt-2","title":"Notes","description":"the widget is slow"},{"id":"gt-3","title":"Other"}]'
+    ;;
+esac
+`)
+
+	hits, err := New(t.TempDir()).SearchWithSnippets("widget", SearchOptions{})
+	if err != nil {
+		t.Fatalf("SearchWithSnippets: %v", err)
+	}
+	if len(hits) != 3 {
+		t.Fatalf("got %d hits, want 3", len(hits))
+	}
+	if hits[0].Issue.ID != "gt-1" || hits[0].Field != "title" || hits[0].Snippet != "Widget sync" {
+		t.Errorf("title hit = %+v", hits[0])
+	}
+	if hits[1].Issue.ID != "gt-2" || hits[1].Field != "description" || hits[1].Snippet != "the widget is slow" {
+		t.Errorf("description hit = %+v", hits[1])
+	}
+	if hits[2].Field != "" || hits[2].Snippet != "" {
+		t.Errorf("hit without a text match = %+v, want no snippet", hits[2])
+	}
+}
+
+func TestExtractSnippet(t *testing.T) {
+	desc := "Widget crashes on start. The sync loop retries forever; see the log for the Widget"
+	tests := []struct {
+		name, query, want string
+	}{
+		{"start", "widget", "Widget crashes o…"},
+		{"middle", "sync", "…tart. The sync loop retr…"},
+		{"end", "log for the widget", "…; see the log for the Widget"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := extractSnippet(desc, tt.query, 10)
+			if !ok || got != tt.want {
+				t.Errorf("extractSnippet(%q) = %q, %v; want %q", tt.query, got, ok, tt.want)
+			}
+		})
+	}
+
+	// Clipping counts runes, so multibyte text stays valid
+	got, ok := extractSnippet("日本語のテキストでウィジェットが壊れる問題について", "ウィジェット", 3)
+	if !ok || got != "…ストでウィジェットが壊れ…" {
+		t.Errorf("multibyte snippet = %q, %v", got, ok)
+	}
+	if _, ok := extractSnippet("nothing here", "widget", 10); ok {
+		t.Error("extractSnippet matched text without the query")
+	}
+}
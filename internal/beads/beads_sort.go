@@ -0,0 +1,46 @@
+// Package beads provides client-side ordering for issue lists.
+package beads
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// sortIssues stably orders issues by key, reversed if desc. Ties keep
+// their original relative order in both directions.
+func sortIssues(issues []*Issue, key string, desc bool) error {
+	var cmp func(a, b *Issue) int
+	switch key {
+	case SortByCreatedAt:
+		cmp = func(a, b *Issue) int { return compareTimestamps(a.CreatedAt, b.CreatedAt) }
+	case SortByUpdatedAt:
+		cmp = func(a, b *Issue) int { return compareTimestamps(a.UpdatedAt, b.UpdatedAt) }
+	case SortByPriority:
+		cmp = func(a, b *Issue) int { return a.Priority - b.Priority }
+	case SortByID:
+		cmp = func(a, b *Issue) int { return strings.Compare(a.ID, b.ID) }
+	default:
+		return fmt.Errorf("unknown sort key %q", key)
+	}
+
+	sort.SliceStable(issues, func(i, j int) bool {
+		if desc {
+			return cmp(issues[i], issues[j]) > 0
+		}
+		return cmp(issues[i], issues[j]) < 0
+	})
+	return nil
+}
+
+// compareTimestamps compares RFC 3339 timestamps, falling back to string
+// comparison when either cannot be parsed.
+func compareTimestamps(a, b string) int {
+	ta, errA := time.Parse(time.RFC3339, a)
+	tb, errB := time.Parse(time.RFC3339, b)
+	if errA != nil || errB != nil {
+		return strings.Compare(a, b)
+	}
+	return ta.Compare(tb)
+}
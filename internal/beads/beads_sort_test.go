@@ -0,0 +1,57 @@
+package beads
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSortIssues(t *testing.T) {
+	issues := func() []*Issue {
+		return []*Issue{
+			{ID: "gt-b", Priority: 2, CreatedAt: "2026-01-02T00:00:00Z", UpdatedAt: "2026-01-09T00:00:00Z"},
+			{ID: "gt-c", Priority: 0, CreatedAt: "2026-01-03T00:00:00Z", UpdatedAt: "2026-01-05T00:00:00Z"},
+			{ID: "gt-a", Priority: 2, CreatedAt: "2026-01-01T00:00:00Z", UpdatedAt: "2026-01-07T00:00:00+02:00"},
+		}
+	}
+
+	tests := []struct {
+		key  string
+		desc bool
+		want string
+	}{
+		{SortByCreatedAt, false, "gt-a,gt-b,gt-c"},
+		{SortByCreatedAt, true, "gt-c,gt-b,gt-a"},
+		{SortByUpdatedAt, false, "gt-c,gt-a,gt-b"},
+		{SortByUpdatedAt, true, "gt-b,gt-a,gt-c"},
+		{SortByPriority, false, "gt-c,gt-b,gt-a"}, // ties keep input order
+		{SortByPriority, true, "gt-b,gt-a,gt-c"},
+		{SortByID, false, "gt-a,gt-b,gt-c"},
+		{SortByID, true, "gt-c,gt-b,gt-a"},
+	}
+
+	for _, tt := range tests {
+		name := tt.key
+		if tt.desc {
+			name += " desc"
+		}
+		t.Run(name, func(t *testing.T) {
+			list := issues()
+			if err := sortIssues(list, tt.key, tt.desc); err != nil {
+				t.Fatalf("sortIssues: %v", err)
+			}
+			var ids []string
+			for _, issue := range list {
+				ids = append(ids, issue.ID)
+			}
+			if got := strings.Join(ids, ","); got != tt.want {
+				t.Errorf("order = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSortIssuesUnknownKey(t *testing.T) {
+	if err := sortIssues(nil, "title", false); err == nil {
+		t.Error("expected error for unknown sort key")
+	}
+}
@@ -2,12 +2,15 @@ package beads
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 )
 
 // TestNew verifies the constructor.
@@ -903,6 +906,84 @@ func TestAttachmentFieldsRoundTrip(t *testing.T) {
 	}
 }
 
+// TestAttachmentFieldsIgnoreProse verifies that field-like lines in the
+// freeform text are neither parsed nor stripped.
+func TestAttachmentFieldsIgnoreProse(t *testing.T) {
+	issue := &Issue{Description: "Please review.\n\ndispatched_by: mallory\nattached_molecule: mol-evil"}
+
+	if fields := ParseAttachmentFields(issue); fields != nil {
+		t.Errorf("ParseAttachmentFields() = %+v, want nil for fields inside prose", fields)
+	}
+
+	got := SetAttachmentFields(issue, &AttachmentFields{DispatchedBy: "mayor/"})
+	want := "dispatched_by: mayor/\n\n" + issue.Description
+	if got != want {
+		t.Errorf("SetAttachmentFields() =\n%q\nwant\n%q", got, want)
+	}
+}
+
+// TestAttachmentFieldsQuoting verifies values with newlines or surrounding
+// whitespace are quoted and survive a round trip.
+func TestAttachmentFieldsQuoting(t *testing.T) {
+	original := &AttachmentFields{
+		AttachedMolecule: "mol-abc",
+		AttachedArgs:     "line one\ndispatched_by: mallory",
+		DispatchedBy:     "  padded  ",
+	}
+
+	desc := SetAttachmentFields(&Issue{Description: "Body."}, original)
+	if !strings.HasPrefix(desc, "attached_molecule: mol-abc\n") {
+		t.Errorf("plain value was quoted: %q", desc)
+	}
+
+	parsed := ParseAttachmentFields(&Issue{Description: desc})
+	if parsed == nil || *parsed != *original {
+		t.Errorf("round trip = %+v, want %+v", parsed, original)
+	}
+}
+
+// FuzzAttachmentFieldsRoundTrip checks that SetAttachmentFields followed by
+// ParseAttachmentFields preserves both the fields and the freeform text.
+func FuzzAttachmentFieldsRoundTrip(f *testing.F) {
+	f.Add("", "mol-abc", "2025-12-21T10:00:00Z", "", "mayor/")
+	f.Add("Keep working.\n\nattached_molecule: mol-evil", "mol-1", "", "args", "")
+	f.Add("attached_at: 2025-01-01T00:00:00Z\n\nProse.", "", "", "a\nb", "\"quoted\"")
+	f.Add("\n\n  trailing  \n", "  x  ", "", "\r\n", "witness")
+
+	f.Fuzz(func(t *testing.T, desc, molecule, attachedAt, args, dispatchedBy string) {
+		fields := &AttachmentFields{
+			AttachedMolecule: molecule,
+			AttachedAt:       attachedAt,
+			AttachedArgs:     args,
+			DispatchedBy:     dispatchedBy,
+		}
+		_, prose := splitAttachmentHeader(desc)
+
+		updated := SetAttachmentFields(&Issue{Description: desc}, fields)
+
+		got := ParseAttachmentFields(&Issue{Description: updated})
+		if *fields == (AttachmentFields{}) {
+			if got != nil {
+				t.Fatalf("ParseAttachmentFields() = %+v, want nil", got)
+			}
+		} else if got == nil || *got != *fields {
+			t.Fatalf("ParseAttachmentFields() = %+v, want %+v (description %q)", got, fields, updated)
+		}
+
+		// Fields written on top of existing text must not disturb it.
+		if *fields != (AttachmentFields{}) {
+			if _, gotProse := splitAttachmentHeader(updated); gotProse != prose {
+				t.Fatalf("prose = %q, want %q", gotProse, prose)
+			}
+		}
+
+		// Clearing the fields restores the freeform text exactly.
+		if cleared := SetAttachmentFields(&Issue{Description: updated}, nil); *fields != (AttachmentFields{}) && cleared != prose {
+			t.Fatalf("cleared description = %q, want %q", cleared, prose)
+		}
+	})
+}
+
 // TestResolveBeadsDir tests the redirect following logic.
 func TestResolveBeadsDir(t *testing.T) {
 	// Create temp directory structure
@@ -1017,6 +1098,121 @@ func TestResolveBeadsDir(t *testing.T) {
 	})
 }
 
+// writeRedirect creates dir/.beads/redirect pointing at target.
+func writeRedirect(t *testing.T, dir, target string) {
+	t.Helper()
+	beadsDir := filepath.Join(dir, ".beads")
+	if err := os.MkdirAll(beadsDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(beadsDir, "redirect"), []byte(target+"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestResolveBeadsDirChains tests multi-level redirect chains and cycles.
+func TestResolveBeadsDirChains(t *testing.T) {
+	t.Run("two levels", func(t *testing.T) {
+		// crew/max -> rig -> mayor/rig
+		rig := t.TempDir()
+		writeRedirect(t, filepath.Join(rig, "crew", "max"), "../../.beads")
+		writeRedirect(t, rig, "mayor/rig/.beads")
+		want := filepath.Join(rig, "mayor", "rig", ".beads")
+
+		if got := ResolveBeadsDir(filepath.Join(rig, "crew", "max")); got != want {
+			t.Errorf("ResolveBeadsDir() = %q, want %q", got, want)
+		}
+		got, err := ResolveBeadsDirStrict(filepath.Join(rig, "crew", "max"))
+		if err != nil || got != want {
+			t.Errorf("ResolveBeadsDirStrict() = %q, %v, want %q", got, err, want)
+		}
+	})
+
+	t.Run("three levels", func(t *testing.T) {
+		// polecats/nux/rig -> polecats/nux -> rig -> mayor/rig
+		rig := t.TempDir()
+		worktree := filepath.Join(rig, "polecats", "nux", "rig")
+		writeRedirect(t, worktree, "../.beads")
+		writeRedirect(t, filepath.Join(rig, "polecats", "nux"), "../../.beads")
+		writeRedirect(t, rig, "mayor/rig/.beads")
+		want := filepath.Join(rig, "mayor", "rig", ".beads")
+
+		if got := ResolveBeadsDir(worktree); got != want {
+			t.Errorf("ResolveBeadsDir() = %q, want %q", got, want)
+		}
+		got, err := ResolveBeadsDirStrict(worktree)
+		if err != nil || got != want {
+			t.Errorf("ResolveBeadsDirStrict() = %q, %v, want %q", got, err, want)
+		}
+	})
+
+	t.Run("cycle", func(t *testing.T) {
+		// a -> b -> a
+		root := t.TempDir()
+		a := filepath.Join(root, "a")
+		writeRedirect(t, a, "../b/.beads")
+		writeRedirect(t, filepath.Join(root, "b"), "../a/.beads")
+
+		done := make(chan struct{})
+		var got string
+		var err error
+		go func() {
+			got, err = ResolveBeadsDirStrict(a)
+			close(done)
+		}()
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+			t.Fatal("ResolveBeadsDirStrict() hung on a redirect cycle")
+		}
+		if !errors.Is(err, ErrRedirectCycle) {
+			t.Errorf("ResolveBeadsDirStrict() = %q, %v, want ErrRedirectCycle", got, err)
+		}
+
+		// The lenient resolver falls back to the local .beads.
+		if got := ResolveBeadsDir(a); got != filepath.Join(a, ".beads") {
+			t.Errorf("ResolveBeadsDir() = %q, want local .beads", got)
+		}
+	})
+}
+
+// TestBeadsRepoKind tests telling real repos from redirect-only worktrees.
+func TestBeadsRepoKind(t *testing.T) {
+	root := t.TempDir()
+	rig := filepath.Join(root, "rig")
+	if err := os.MkdirAll(filepath.Join(rig, ".beads"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	worktree := filepath.Join(root, "crew", "max")
+	writeRedirect(t, worktree, "../../rig/.beads")
+	dangling := filepath.Join(root, "crew", "gone")
+	writeRedirect(t, dangling, "../../missing/.beads")
+
+	tests := []struct {
+		name    string
+		workDir string
+		want    RepoKind
+		wantErr bool
+	}{
+		{"real repo", rig, RepoKindReal, false},
+		{"redirect to real repo", worktree, RepoKindRedirect, false},
+		{"dangling redirect", dangling, RepoKindRedirect, true},
+		{"no beads dir", filepath.Join(root, "crew"), RepoKindNone, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := BeadsRepoKind(tt.workDir)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("BeadsRepoKind() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("BeadsRepoKind() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestParseAgentBeadID(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -2380,3 +2576,479 @@ func TestCloseAndClearAgentBead_ReasonVariations(t *testing.T) {
 		})
 	}
 }
+
+// TestPrime tests PRIME.md overrides and placeholder substitution.
+func TestPrime(t *testing.T) {
+	vars := PrimeVars{Town: "gt", Rig: "gastown", Role: "polecat"}
+
+	t.Run("fallback to built-in content", func(t *testing.T) {
+		got := New(t.TempDir()).Prime(vars)
+		if got != primeContent {
+			t.Errorf("Prime() without PRIME.md should return the built-in content")
+		}
+	})
+
+	t.Run("custom PRIME.md with variables", func(t *testing.T) {
+		dir := t.TempDir()
+		beadsDir := filepath.Join(dir, ".beads")
+		if err := os.MkdirAll(beadsDir, 0755); err != nil {
+			t.Fatal(err)
+		}
+		custom := "# {{town}} worker\nYou are a {{role}} in {{rig}}. {{unknown}} stays."
+		if err := os.WriteFile(filepath.Join(beadsDir, "PRIME.md"), []byte(custom), 0644); err != nil {
+			t.Fatal(err)
+		}
+
+		got := New(dir).Prime(vars)
+		want := "# gt worker\nYou are a polecat in gastown. {{unknown}} stays."
+		if got != want {
+			t.Errorf("Prime() = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("custom PRIME.md through redirect", func(t *testing.T) {
+		root := t.TempDir()
+		rigBeads := filepath.Join(root, "rig", ".beads")
+		if err := os.MkdirAll(rigBeads, 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(rigBeads, "PRIME.md"), []byte("rig {{rig}}"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		worktree := filepath.Join(root, "crew", "max")
+		writeRedirect(t, worktree, "../../rig/.beads")
+
+		if got := New(worktree).Prime(vars); got != "rig gastown" {
+			t.Errorf("Prime() = %q, want %q", got, "rig gastown")
+		}
+	})
+}
+
+// TestListCreatedBy tests that the creator filter combines with bd filters.
+func TestListCreatedBy(t *testing.T) {
+	calls := installBDStub(t, `
+case "$cmd" in
+  list)
+    printf '%s\n' '[{"id":"gt-1","created_by":"gastown/polecats/Toast"},{"id":"gt-2","created_by":"gastown/polecats/Nux"},{"id":"gt-3","created_by":"gastown/polecats/Toast"}]'
+    ;;
+esac
+`)
+
+	issues, err := New(t.TempDir()).List(ListOptions{
+		Status:    "open",
+		Priority:  -1,
+		CreatedBy: "gastown/polecats/Toast",
+	})
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(issues) != 2 || issues[0].ID != "gt-1" || issues[1].ID != "gt-3" {
+		t.Errorf("List(CreatedBy) = %v, want gt-1 and gt-3", issues)
+	}
+	if !hasCall(calls(), "list", "--status=open") {
+		t.Errorf("status filter not passed to bd: %v", calls())
+	}
+}
+
+// TestListDependencyFilters tests the leaf and blocked-by filters.
+func TestListDependencyFilters(t *testing.T) {
+	installBDStub(t, `
+case "$cmd" in
+  list)
+    printf '%s\n' '[{"id":"gt-leaf","dependent_count":1},{"id":"gt-mid","dependency_count":1,"dependent_count":1,"blocked_by_count":1},{"id":"gt-top","dependency_count":2,"blocked_by_count":2}]'
+    ;;
+esac
+`)
+	b := New(t.TempDir())
+	no, yes := false, true
+
+	ids := func(issues []*Issue) []string {
+		var out []string
+		for _, issue := range issues {
+			out = append(out, issue.ID)
+		}
+		return out
+	}
+	tests := []struct {
+		name string
+		opts ListOptions
+		want []string
+	}{
+		{"leaves", ListOptions{Priority: -1, HasDependencies: &no}, []string{"gt-leaf"}},
+		{"has dependents", ListOptions{Priority: -1, HasDependents: &yes}, []string{"gt-leaf", "gt-mid"}},
+		{"blocked", ListOptions{Priority: -1, MinBlockedBy: 1}, []string{"gt-mid", "gt-top"}},
+		{"heavily blocked", ListOptions{Priority: -1, MinBlockedBy: 2}, []string{"gt-top"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issues, err := b.List(tt.opts)
+			if err != nil {
+				t.Fatalf("List: %v", err)
+			}
+			if got := ids(issues); strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("List() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestListLimitAfterClientFilters tests that Limit counts issues passing
+// the client-side filters and sort, not bd's first rows.
+func TestListLimitAfterClientFilters(t *testing.T) {
+	calls := installBDStub(t, `
+limit=0
+for arg in "$@"; do
+  case "$arg" in --limit=*) limit="${arg#--limit=}" ;; esac
+done
+case "$cmd" in
+  list)
+    if [ "$limit" = 2 ]; then
+      printf '%s\n' '[{"id":"gt-1","created_by":"mayor","priority":3},{"id":"gt-2","created_by":"deacon","priority":1}]'
+    else
+      printf '%s\n' '[{"id":"gt-1","created_by":"mayor","priority":3},{"id":"gt-2","created_by":"deacon","priority":1},{"id":"gt-3","created_by":"mayor","priority":0},{"id":"gt-4","created_by":"mayor","priority":2}]'
+    fi
+    ;;
+esac
+`)
+	b := New(t.TempDir())
+
+	tests := []struct {
+		name string
+		opts ListOptions
+		want string
+	}{
+		{"bd limit", ListOptions{Priority: -1, Limit: 2}, "gt-1,gt-2"},
+		{"filter", ListOptions{Priority: -1, Limit: 2, CreatedBy: "mayor"}, "gt-1,gt-3"},
+		{"filter and sort", ListOptions{Priority: -1, Limit: 2, CreatedBy: "mayor", SortBy: SortByPriority}, "gt-3,gt-4"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issues, err := b.List(tt.opts)
+			if err != nil {
+				t.Fatalf("List: %v", err)
+			}
+			if got := strings.Join(issueIDs(issues), ","); got != tt.want {
+				t.Errorf("List() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+
+	for _, c := range calls()[1:] {
+		if strings.Contains(c, "--limit=2") {
+			t.Errorf("limit passed to bd with client-side filters: %s", c)
+		}
+	}
+}
+
+// TestListCap tests that uncapped lists stop at ListCap with a warning.
+func TestListCap(t *testing.T) {
+	calls := installBDStub(t, `
+case "$cmd" in
+  list) printf '%s\n' '[{"id":"gt-1"},{"id":"gt-2"},{"id":"gt-3"},{"id":"gt-4"}]' ;;
+esac
+`)
+	orig := ListCap
+	ListCap = 3
+	t.Cleanup(func() { ListCap = orig })
+	b := New(t.TempDir())
+
+	var issues []*Issue
+	stderr := captureStderr(t, func() {
+		var err error
+		issues, err = b.List(ListOptions{Priority: -1})
+		if err != nil {
+			t.Fatalf("List: %v", err)
+		}
+	})
+	if len(issues) != 3 {
+		t.Errorf("got %d issues, want 3 (the cap)", len(issues))
+	}
+	if !strings.Contains(stderr, "truncating") {
+		t.Errorf("expected truncation warning, got %q", stderr)
+	}
+	if !hasCall(calls(), "list", "--limit=4") {
+		t.Errorf("expected bd list --limit=4, got %v", calls())
+	}
+
+	stderr = captureStderr(t, func() {
+		var err error
+		issues, err = b.List(ListOptions{Priority: -1, NoCap: true})
+		if err != nil {
+			t.Fatalf("List(NoCap): %v", err)
+		}
+	})
+	if len(issues) != 4 || stderr != "" {
+		t.Errorf("NoCap: got %d issues, warning %q; want 4 and no warning", len(issues), stderr)
+	}
+	if !hasCall(calls(), "list", "--limit=0") {
+		t.Errorf("expected bd list --limit=0 with NoCap, got %v", calls())
+	}
+}
+
+// captureStderr returns what fn writes to os.Stderr.
+func captureStderr(t *testing.T, fn func()) string {
+	t.Helper()
+	old := os.Stderr
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("create pipe: %v", err)
+	}
+	os.Stderr = w
+
+	fn()
+
+	_ = w.Close()
+	os.Stderr = old
+
+	out, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("read stderr: %v", err)
+	}
+	_ = r.Close()
+	return string(out)
+}
+
+// TestListUpdatedSince tests that only issues changed after the snapshot return.
+func TestListUpdatedSince(t *testing.T) {
+	installBDStub(t, `
+case "$cmd" in
+  list)
+    printf '%s\n' '[{"id":"gt-old","updated_at":"2026-01-05T09:00:00Z"},{"id":"gt-new","updated_at":"2026-01-05T10:00:01Z"},{"id":"gt-edge","updated_at":"2026-01-05T10:00:00Z"}]'
+    ;;
+esac
+`)
+
+	snapshot := time.Date(2026, 1, 5, 10, 0, 0, 0, time.UTC)
+	issues, err := New(t.TempDir()).ListUpdatedSince(snapshot, ListOptions{Priority: -1})
+	if err != nil {
+		t.Fatalf("ListUpdatedSince: %v", err)
+	}
+	if len(issues) != 1 || issues[0].ID != "gt-new" {
+		t.Errorf("ListUpdatedSince() = %v, want only gt-new", issues)
+	}
+}
+
+// depStub answers bd create with gt-new and fails dep add onto gt-missing.
+const depStub = `
+case "$cmd" in
+  create) printf '%s\n' '{"id":"gt-new","title":"New"}' ;;
+  dep)
+    case "$*" in
+      *gt-missing*) echo "Error: issue gt-missing not found" >&2; exit 1 ;;
+    esac
+    echo "ok"
+    ;;
+  delete) echo "deleted" ;;
+esac
+`
+
+// TestCreateDependsOn tests that Create links dependencies and rolls back on failure.
+func TestCreateDependsOn(t *testing.T) {
+	t.Run("links all dependencies", func(t *testing.T) {
+		calls := installBDStub(t, depStub)
+		issue, err := New(t.TempDir()).Create(CreateOptions{
+			Title:          "New",
+			Priority:       -1,
+			DependsOn:      []string{"gt-a", "gt-b"},
+			DependsOnTyped: []TypedDep{{ID: "hq-cv-1", Type: DepTypeTracks}},
+		})
+		if err != nil {
+			t.Fatalf("Create: %v", err)
+		}
+		if issue.ID != "gt-new" {
+			t.Errorf("ID = %q, want gt-new", issue.ID)
+		}
+		for _, want := range [][]string{
+			{"dep add gt-new gt-a", "--type=blocks"},
+			{"dep add gt-new gt-b", "--type=blocks"},
+			{"dep add gt-new hq-cv-1", "--type=tracks"},
+		} {
+			if !hasCall(calls(), want...) {
+				t.Errorf("missing call %v in %v", want, calls())
+			}
+		}
+	})
+
+	t.Run("rolls back when linking fails", func(t *testing.T) {
+		calls := installBDStub(t, depStub)
+		_, err := New(t.TempDir()).Create(CreateOptions{
+			Title:     "New",
+			Priority:  -1,
+			DependsOn: []string{"gt-a", "gt-missing"},
+		})
+		if err == nil {
+			t.Fatal("expected error when a dependency cannot be linked")
+		}
+		if !hasCall(calls(), "delete gt-new", "--hard") {
+			t.Errorf("new issue not rolled back: %v", calls())
+		}
+	})
+}
+
+// TestCreateAssignee tests that a bead created with an assignee is
+// returned by ListByAssignee without a follow-up update.
+func TestCreateAssignee(t *testing.T) {
+	calls := installBDStub(t, `
+case "$cmd" in
+  create) printf '%s\n' '{"id":"gt-new","title":"New","assignee":"gastown/polecats/Toast"}' ;;
+  list)
+    case "$*" in
+      *--assignee=gastown/polecats/Toast*) printf '%s\n' '[{"id":"gt-new","assignee":"gastown/polecats/Toast"}]' ;;
+      *) echo '[]' ;;
+    esac
+    ;;
+esac
+`)
+	b := New(t.TempDir())
+
+	if _, err := b.Create(CreateOptions{Title: "New", Priority: -1, Assignee: "gastown/polecats/Toast"}); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if !hasCall(calls(), "create", "--assignee=gastown/polecats/Toast") {
+		t.Errorf("assignee not passed to bd create: %v", calls())
+	}
+	if hasCall(calls(), "update gt-new") {
+		t.Errorf("unexpected follow-up update: %v", calls())
+	}
+
+	issues, err := b.ListByAssignee("gastown/polecats/Toast")
+	if err != nil {
+		t.Fatalf("ListByAssignee: %v", err)
+	}
+	if len(issues) != 1 || issues[0].ID != "gt-new" {
+		t.Errorf("ListByAssignee() = %v, want gt-new", issues)
+	}
+}
+
+// TestInitWithOptions tests that config keys are in place as soon as
+// InitWithOptions returns.
+func TestInitWithOptions(t *testing.T) {
+	calls := installBDStub(t, `
+cfg="${BD_LOG}.cfg"
+case "$cmd" in
+  config)
+    case "$1" in
+      set) printf '%s=%s\n' "$2" "$3" >> "$cfg" ;;
+      get) grep "^$2=" "$cfg" 2>/dev/null | cut -d= -f2- || echo "$2 (not set)" ;;
+    esac
+    ;;
+esac
+`)
+	b := New(t.TempDir())
+	config := map[string]string{
+		"types.custom":     "agent,role,rig,convoy",
+		"sync.branch":      "beads-sync",
+		"allowed_prefixes": "gt,hq",
+	}
+
+	if err := b.InitWithOptions(InitOptions{Prefix: "gt", Config: config}); err != nil {
+		t.Fatalf("InitWithOptions: %v", err)
+	}
+	if !hasCall(calls(), "init --prefix gt") {
+		t.Errorf("bd init not called: %v", calls())
+	}
+	for key, want := range config {
+		got, err := b.GetConfig(key)
+		if err != nil {
+			t.Fatalf("GetConfig(%s): %v", key, err)
+		}
+		if got != want {
+			t.Errorf("GetConfig(%s) = %q, want %q", key, got, want)
+		}
+	}
+}
+
+// TestInitWithOptionsConfigError tests that a rejected config key is
+// reported as a ConfigError after the remaining keys are still set.
+func TestInitWithOptionsConfigError(t *testing.T) {
+	calls := installBDStub(t, `
+case "$cmd:$2" in
+  config:types.custom) echo "unknown config key" >&2; exit 1 ;;
+esac
+`)
+	b := New(t.TempDir())
+	config := map[string]string{
+		"allowed_prefixes": "gt,hq",
+		"types.custom":     "agent",
+		"zz.last":          "x",
+	}
+
+	err := b.InitWithOptions(InitOptions{Prefix: "gt", Config: config})
+	var cfgErr *ConfigError
+	if !errors.As(err, &cfgErr) {
+		t.Fatalf("InitWithOptions = %v, want ConfigError", err)
+	}
+	if strings.Join(cfgErr.Keys, ",") != "types.custom" {
+		t.Errorf("failed keys = %v, want types.custom", cfgErr.Keys)
+	}
+	if !hasCall(calls(), "config set zz.last x") {
+		t.Errorf("keys after the failure not set: %v", calls())
+	}
+}
+
+// TestShowOrdered tests request ordering, duplicate collapsing, and missing IDs.
+func TestShowOrdered(t *testing.T) {
+	calls := installBDStub(t, `
+case "$cmd" in
+  show) printf '%s\n' '[{"id":"gt-a"},{"id":"gt-b"},{"id":"gt-c"}]' ;;
+esac
+`)
+
+	issues, missing, err := New(t.TempDir()).ShowOrdered([]string{"gt-c", "gt-x", "gt-a", "gt-c", "gt-y", "gt-b"})
+	if err != nil {
+		t.Fatalf("ShowOrdered: %v", err)
+	}
+
+	var ids []string
+	for _, issue := range issues {
+		ids = append(ids, issue.ID)
+	}
+	if got := strings.Join(ids, ","); got != "gt-c,gt-a,gt-b" {
+		t.Errorf("issues = %s, want gt-c,gt-a,gt-b", got)
+	}
+	if got := strings.Join(missing, ","); got != "gt-x,gt-y" {
+		t.Errorf("missing = %s, want gt-x,gt-y", got)
+	}
+	if !hasCall(calls(), "show --json gt-c gt-x gt-a gt-y gt-b") {
+		t.Errorf("expected one deduplicated bd show call, got %v", calls())
+	}
+}
+
+// TestShowOrderedErrors tests that bd failures are returned and a batch
+// rejected for a missing ID falls back to per-ID lookups.
+func TestShowOrderedErrors(t *testing.T) {
+	t.Run("bd failure", func(t *testing.T) {
+		installBDStub(t, `
+case "$cmd" in
+  show) echo "Error: database locked" >&2; exit 1 ;;
+esac
+`)
+		if _, _, err := New(t.TempDir()).ShowOrdered([]string{"gt-a"}); err == nil || !strings.Contains(err.Error(), "database locked") {
+			t.Errorf("ShowOrdered = %v, want the bd error", err)
+		}
+	})
+
+	t.Run("batch rejected for a missing ID", func(t *testing.T) {
+		calls := installBDStub(t, `
+case "$cmd" in
+  show)
+    if [ "$#" -gt 2 ]; then echo "Error: issue gt-x not found" >&2; exit 1; fi
+    case "$1" in
+      gt-x) echo "Error: issue gt-x not found" >&2; exit 1 ;;
+      *) printf '[{"id":"%s"}]\n' "$1" ;;
+    esac
+    ;;
+esac
+`)
+		issues, missing, err := New(t.TempDir()).ShowOrdered([]string{"gt-a", "gt-x"})
+		if err != nil {
+			t.Fatalf("ShowOrdered: %v", err)
+		}
+		if len(issues) != 1 || issues[0].ID != "gt-a" || strings.Join(missing, ",") != "gt-x" {
+			t.Errorf("ShowOrdered = %v, missing %v; want gt-a, missing gt-x", issueIDs(issues), missing)
+		}
+		if !hasCall(calls(), "show gt-a --json") {
+			t.Errorf("no per-ID fallback: %v", calls())
+		}
+	})
+}
@@ -0,0 +1,216 @@
+// Package beads provides town-wide operations across every routed database.
+package beads
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// townRigName is the Rig tag for beads from the town-level database.
+const townRigName = "town"
+
+// TownConcurrency caps how many rigs a town-wide operation queries at once,
+// so large towns don't spawn a bd process per rig simultaneously.
+var TownConcurrency = 4
+
+// RigError is a failure in one rig during a town-wide operation.
+type RigError struct {
+	Rig string
+	Err error
+}
+
+// RigErrors reports the rigs a town-wide operation could not reach. The
+// operation's results still cover every other rig.
+type RigErrors []RigError
+
+func (e RigErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, re := range e {
+		msgs[i] = fmt.Sprintf("%s: %v", re.Rig, re.Err)
+	}
+	return fmt.Sprintf("%d rig(s) failed: %s", len(e), strings.Join(msgs, "; "))
+}
+
+// townRoutePaths returns the distinct database paths in the town's
+// routes.jsonl, in route order. Routes sharing a path (e.g., hq- and
+// hq-cv-) are returned once.
+func (b *Beads) townRoutePaths() ([]string, error) {
+	routes, err := LoadRoutes(filepath.Join(b.workDir, ".beads"))
+	if err != nil {
+		return nil, fmt.Errorf("loading routes: %w", err)
+	}
+	var paths []string
+	seen := make(map[string]bool)
+	for _, r := range routes {
+		if !seen[r.Path] {
+			seen[r.Path] = true
+			paths = append(paths, r.Path)
+		}
+	}
+	return paths, nil
+}
+
+// forEachRig calls fn for each route path with at most concurrency calls in
+// flight (TownConcurrency if concurrency <= 0). A failing rig does not stop
+// the others; failures are returned as RigErrors in path order, or nil.
+func forEachRig(paths []string, concurrency int, fn func(i int, path string) error) error {
+	if concurrency <= 0 {
+		concurrency = TownConcurrency
+	}
+	errs := make([]error, len(paths))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i, path := range paths {
+		wg.Add(1)
+		go func(i int, path string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			errs[i] = fn(i, path)
+		}(i, path)
+	}
+	wg.Wait()
+
+	var failed RigErrors
+	for i, err := range errs {
+		if err != nil {
+			failed = append(failed, RigError{Rig: routeRigName(paths[i]), Err: err})
+		}
+	}
+	if len(failed) > 0 {
+		return failed
+	}
+	return nil
+}
+
+// ListAllRigs runs List with opts against every database in the town's
+// routes.jsonl (the receiver's workDir is the town root) and merges the
+// results in route order. Each issue is tagged with the rig it came from.
+// If some rigs fail, the rest are still returned along with a RigErrors.
+func (b *Beads) ListAllRigs(opts ListOptions) ([]*Issue, error) {
+	paths, err := b.townRoutePaths()
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([][]*Issue, len(paths))
+	err = forEachRig(paths, 0, func(i int, path string) error {
+		issues, err := New(filepath.Join(b.workDir, path)).List(opts)
+		if err != nil {
+			return err
+		}
+		rig := routeRigName(path)
+		for _, issue := range issues {
+			issue.Rig = rig
+		}
+		results[i] = issues
+		return nil
+	})
+
+	var all []*Issue
+	for _, issues := range results {
+		all = append(all, issues...)
+	}
+	return all, err
+}
+
+// RepoStats counts the issues in one beads database by status.
+type RepoStats struct {
+	Total      int `json:"total"`
+	Open       int `json:"open"`
+	InProgress int `json:"in_progress"`
+	Hooked     int `json:"hooked"`
+	Closed     int `json:"closed"`
+	Blocked    int `json:"blocked"` // Not closed and waiting on at least one open blocker
+}
+
+// add accumulates o into s, for town rollups.
+func (s *RepoStats) add(o *RepoStats) {
+	s.Total += o.Total
+	s.Open += o.Open
+	s.InProgress += o.InProgress
+	s.Hooked += o.Hooked
+	s.Closed += o.Closed
+	s.Blocked += o.Blocked
+}
+
+// RepoStats counts every issue in the database by status. The counts come
+// from bd's aggregate count and the blocked list, so the database's issues
+// are never listed in full.
+func (b *Beads) RepoStats() (*RepoStats, error) {
+	out, err := b.run("count", "--by-status", "--json")
+	if err != nil {
+		return nil, err
+	}
+	var counts struct {
+		Total  int `json:"total"`
+		Groups []struct {
+			Group string `json:"group"`
+			Count int    `json:"count"`
+		} `json:"groups"`
+	}
+	if err := json.Unmarshal(out, &counts); err != nil {
+		return nil, fmt.Errorf("parsing bd count output: %w", err)
+	}
+
+	stats := &RepoStats{Total: counts.Total}
+	for _, g := range counts.Groups {
+		switch g.Group {
+		case "open":
+			stats.Open = g.Count
+		case "in_progress":
+			stats.InProgress = g.Count
+		case StatusHooked:
+			stats.Hooked = g.Count
+		case "closed":
+			stats.Closed = g.Count
+		}
+	}
+
+	blocked, err := b.Blocked()
+	if err != nil {
+		return nil, err
+	}
+	stats.Blocked = len(blocked)
+	return stats, nil
+}
+
+// StatsAllRigs returns RepoStats for every database in the town's
+// routes.jsonl, keyed by rig, plus a town-wide rollup. If some rigs fail,
+// the rest (and a rollup over them) are still returned along with a
+// RigErrors.
+func (b *Beads) StatsAllRigs() (map[string]*RepoStats, *RepoStats, error) {
+	paths, err := b.townRoutePaths()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	results := make([]*RepoStats, len(paths))
+	err = forEachRig(paths, 0, func(i int, path string) error {
+		stats, err := New(filepath.Join(b.workDir, path)).RepoStats()
+		results[i] = stats
+		return err
+	})
+
+	perRig := make(map[string]*RepoStats, len(paths))
+	total := &RepoStats{}
+	for i, path := range paths {
+		if results[i] != nil {
+			perRig[routeRigName(path)] = results[i]
+			total.add(results[i])
+		}
+	}
+	return perRig, total, err
+}
+
+// routeRigName returns the rig a route path belongs to: its first path
+// element (e.g., "gastown" for "gastown/mayor/rig"), or townRigName for ".".
+func routeRigName(path string) string {
+	if path == "." {
+		return townRigName
+	}
+	return strings.SplitN(filepath.ToSlash(path), "/", 2)[0]
+}
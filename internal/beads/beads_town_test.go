@@ -0,0 +1,185 @@
+package beads
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// setupTownRoutes creates a town with a .beads directory per route path and
+// writes routes.jsonl.
+func setupTownRoutes(t *testing.T, routes []Route) string {
+	t.Helper()
+	townRoot := t.TempDir()
+	for _, r := range routes {
+		if err := os.MkdirAll(filepath.Join(townRoot, r.Path, ".beads"), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := WriteRoutes(filepath.Join(townRoot, ".beads"), routes); err != nil {
+		t.Fatal(err)
+	}
+	return townRoot
+}
+
+// townListStub answers bd list with one bead per database, chosen by BEADS_DIR.
+const townListStub = `
+case "$cmd" in
+  list)
+    case "$BEADS_DIR" in
+      */gastown/mayor/rig/.beads) printf '%s\n' '[{"id":"gt-1","title":"Fix widget"}]' ;;
+      */beads/mayor/rig/.beads) printf '%s\n' '[{"id":"bd-1","title":"Speed up sync"}]' ;;
+      *) printf '%s\n' '[{"id":"hq-1","title":"Town chore"}]' ;;
+    esac
+    ;;
+esac
+`
+
+func TestListAllRigs(t *testing.T) {
+	calls := installBDStub(t, townListStub)
+	townRoot := setupTownRoutes(t, []Route{
+		{Prefix: "hq-", Path: "."},
+		{Prefix: "hq-cv-", Path: "."},
+		{Prefix: "gt-", Path: "gastown/mayor/rig"},
+		{Prefix: "bd-", Path: "beads/mayor/rig"},
+	})
+
+	issues, err := New(townRoot).ListAllRigs(ListOptions{Status: "open", Priority: -1})
+	if err != nil {
+		t.Fatalf("ListAllRigs: %v", err)
+	}
+
+	var got []string
+	for _, issue := range issues {
+		got = append(got, issue.ID+"@"+issue.Rig)
+	}
+	if want := "hq-1@town,gt-1@gastown,bd-1@beads"; strings.Join(got, ",") != want {
+		t.Errorf("ListAllRigs() = %s, want %s", strings.Join(got, ","), want)
+	}
+
+	lists := 0
+	for _, c := range calls() {
+		if strings.Contains(c, "list") {
+			lists++
+		}
+	}
+	if lists != 3 {
+		t.Errorf("expected one bd list per database (3), got %d: %v", lists, calls())
+	}
+}
+
+func TestListAllRigsIsolatesRigErrors(t *testing.T) {
+	installBDStub(t, `
+case "$cmd" in
+  list)
+    case "$BEADS_DIR" in
+      */beads/mayor/rig/.beads) echo "Error: database locked" >&2; exit 1 ;;
+      */gastown/mayor/rig/.beads) printf '%s\n' '[{"id":"gt-1"}]' ;;
+      *) printf '%s\n' '[{"id":"hq-1"}]' ;;
+    esac
+    ;;
+esac
+`)
+	townRoot := setupTownRoutes(t, []Route{
+		{Prefix: "hq-", Path: "."},
+		{Prefix: "bd-", Path: "beads/mayor/rig"},
+		{Prefix: "gt-", Path: "gastown/mayor/rig"},
+	})
+
+	issues, err := New(townRoot).ListAllRigs(ListOptions{Priority: -1})
+	var rigErrs RigErrors
+	if !errors.As(err, &rigErrs) || len(rigErrs) != 1 || rigErrs[0].Rig != "beads" {
+		t.Fatalf("err = %v, want RigErrors for beads only", err)
+	}
+	if len(issues) != 2 || issues[0].ID != "hq-1" || issues[1].ID != "gt-1" {
+		t.Errorf("issues = %v, want hq-1 and gt-1 from the healthy rigs", issues)
+	}
+}
+
+func TestForEachRigConcurrencyCap(t *testing.T) {
+	paths := make([]string, 20)
+	for i := range paths {
+		paths[i] = fmt.Sprintf("rig%02d/mayor/rig", i)
+	}
+
+	var mu sync.Mutex
+	active, peak := 0, 0
+	err := forEachRig(paths, 3, func(i int, path string) error {
+		mu.Lock()
+		active++
+		if active > peak {
+			peak = active
+		}
+		mu.Unlock()
+
+		time.Sleep(5 * time.Millisecond)
+
+		mu.Lock()
+		active--
+		mu.Unlock()
+		if i == 7 {
+			return errors.New("boom")
+		}
+		return nil
+	})
+
+	if peak > 3 {
+		t.Errorf("peak concurrency = %d, want <= 3", peak)
+	}
+	var rigErrs RigErrors
+	if !errors.As(err, &rigErrs) || len(rigErrs) != 1 || rigErrs[0].Rig != "rig07" {
+		t.Errorf("err = %v, want a single RigErrors entry for rig07", err)
+	}
+}
+
+func TestStatsAllRigs(t *testing.T) {
+	calls := installBDStub(t, `
+case "$cmd:$BEADS_DIR" in
+  count:*/gastown/mayor/rig/.beads)
+    printf '%s\n' '{"total":3,"groups":[{"group":"open","count":1},{"group":"hooked","count":1},{"group":"closed","count":1}]}' ;;
+  count:*/beads/mayor/rig/.beads)
+    printf '%s\n' '{"total":2,"groups":[{"group":"in_progress","count":1},{"group":"closed","count":1}]}' ;;
+  count:*)
+    printf '%s\n' '{"total":1,"groups":[{"group":"open","count":1}]}' ;;
+  blocked:*/gastown/mayor/rig/.beads)
+    printf '%s\n' '[{"id":"gt-1","status":"open","blocked_by_count":1}]' ;;
+  blocked:*)
+    echo '[]' ;;
+esac
+`)
+	townRoot := setupTownRoutes(t, []Route{
+		{Prefix: "hq-", Path: "."},
+		{Prefix: "gt-", Path: "gastown/mayor/rig"},
+		{Prefix: "bd-", Path: "beads/mayor/rig"},
+	})
+
+	perRig, total, err := New(townRoot).StatsAllRigs()
+	if err != nil {
+		t.Fatalf("StatsAllRigs: %v", err)
+	}
+
+	want := map[string]RepoStats{
+		"town":    {Total: 1, Open: 1},
+		"gastown": {Total: 3, Open: 1, Hooked: 1, Closed: 1, Blocked: 1},
+		"beads":   {Total: 2, InProgress: 1, Closed: 1},
+	}
+	if len(perRig) != len(want) {
+		t.Fatalf("got stats for %d rigs, want %d: %v", len(perRig), len(want), perRig)
+	}
+	for rig, w := range want {
+		if got := perRig[rig]; got == nil || *got != w {
+			t.Errorf("%s stats = %+v, want %+v", rig, got, w)
+		}
+	}
+	if wantTotal := (RepoStats{Total: 6, Open: 2, InProgress: 1, Hooked: 1, Closed: 2, Blocked: 1}); *total != wantTotal {
+		t.Errorf("rollup = %+v, want %+v", *total, wantTotal)
+	}
+	if hasCall(calls(), "list") {
+		t.Errorf("stats listed issues instead of counting: %v", calls())
+	}
+}
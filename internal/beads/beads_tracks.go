@@ -0,0 +1,27 @@
+// Package beads provides non-blocking roll-up of an issue to several parents.
+package beads
+
+// AddParentTracking makes childID roll up to parentID (an epic or convoy)
+// without blocking either: parentID gets a tracks edge to childID, as a
+// convoy does for the work it follows. Unlike Parent, an issue can be
+// tracked by any number of parents.
+func (b *Beads) AddParentTracking(childID, parentID string) error {
+	return b.AddTypedDependency(parentID, childID, DepTypeTracks)
+}
+
+// RemoveParentTracking removes parentID's tracks edge to childID.
+func (b *Beads) RemoveParentTracking(childID, parentID string) error {
+	return b.RemoveDependency(parentID, childID)
+}
+
+// trackingParents returns the issues tracking issue, from the dependents
+// reported by bd show.
+func trackingParents(issue *Issue) []string {
+	var parents []string
+	for _, dep := range issue.Dependents {
+		if dep.DependencyType == DepTypeTracks {
+			parents = append(parents, dep.ID)
+		}
+	}
+	return parents
+}
@@ -0,0 +1,76 @@
+package beads
+
+import (
+	"reflect"
+	"testing"
+)
+
+// installTracksStub keeps dependency edges as "issue dependsOn type" lines
+// and reports them from show as dependencies and dependents.
+func installTracksStub(t *testing.T) {
+	t.Helper()
+	installBDStub(t, `
+edges="${BD_LOG}.edges"
+touch "$edges"
+case "$cmd" in
+  dep)
+    case "$1" in
+      add) echo "$2 $3 ${4#--type=}" >> "$edges" ;;
+      remove) grep -v "^$2 $3 " "$edges" > "$edges.tmp"; mv "$edges.tmp" "$edges" ;;
+    esac
+    ;;
+  show)
+    deps=""; dependents=""
+    while read -r from to typ; do
+      [ "$from" = "$1" ] && deps="$deps${deps:+,}{\"id\":\"$to\",\"dependency_type\":\"$typ\"}"
+      [ "$to" = "$1" ] && dependents="$dependents${dependents:+,}{\"id\":\"$from\",\"dependency_type\":\"$typ\"}"
+    done < "$edges"
+    printf '[{"id":"%s","dependencies":[%s],"dependents":[%s]}]\n' "$1" "$deps" "$dependents"
+    ;;
+esac
+`)
+}
+
+func TestParentTrackingMultipleParents(t *testing.T) {
+	installTracksStub(t)
+	b := New(t.TempDir())
+
+	for _, parent := range []string{"hq-cv-a", "hq-cv-b"} {
+		if err := b.AddParentTracking("gt-abc", parent); err != nil {
+			t.Fatalf("AddParentTracking(%s): %v", parent, err)
+		}
+	}
+	// A blocking dependent is not a roll-up parent
+	if err := b.AddDependency("gt-next", "gt-abc"); err != nil {
+		t.Fatal(err)
+	}
+
+	child, err := b.Show("gt-abc")
+	if err != nil {
+		t.Fatalf("Show: %v", err)
+	}
+	if want := []string{"hq-cv-a", "hq-cv-b"}; !reflect.DeepEqual(child.TracksParents, want) {
+		t.Errorf("TracksParents = %v, want %v", child.TracksParents, want)
+	}
+
+	for _, parent := range []string{"hq-cv-a", "hq-cv-b"} {
+		issue, err := b.Show(parent)
+		if err != nil {
+			t.Fatalf("Show(%s): %v", parent, err)
+		}
+		if len(issue.Dependencies) != 1 || issue.Dependencies[0].ID != "gt-abc" || issue.Dependencies[0].DependencyType != DepTypeTracks {
+			t.Errorf("%s dependencies = %+v, want gt-abc tracked", parent, issue.Dependencies)
+		}
+	}
+
+	if err := b.RemoveParentTracking("gt-abc", "hq-cv-a"); err != nil {
+		t.Fatalf("RemoveParentTracking: %v", err)
+	}
+	child, err = b.Show("gt-abc")
+	if err != nil {
+		t.Fatalf("Show: %v", err)
+	}
+	if want := []string{"hq-cv-b"}; !reflect.DeepEqual(child.TracksParents, want) {
+		t.Errorf("after removal TracksParents = %v, want %v", child.TracksParents, want)
+	}
+}
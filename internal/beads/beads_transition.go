@@ -0,0 +1,155 @@
+// Package beads provides the record of who changed an issue's status and assignee.
+package beads
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// statusHistoryFile is the JSONL log of status and assignee changes made
+// through gt, kept next to audit.log. bd keeps only the current status and
+// assignee and when it closed, not who changed them.
+const statusHistoryFile = "status-history.log"
+
+// Transition is one change of an issue's status, its assignee, or both.
+type Transition struct {
+	Timestamp string  `json:"timestamp"`
+	IssueID   string  `json:"issue_id"`
+	From      string  `json:"from,omitempty"`     // Previous status; empty if unknown
+	To        string  `json:"to,omitempty"`       // New status; empty if unchanged
+	Assignee  *string `json:"assignee,omitempty"` // New assignee if changed; "" when cleared
+	Actor     string  `json:"actor,omitempty"`    // Who made the change, if known
+}
+
+// logTransition appends a status and/or assignee change to the status
+// history; an empty to or nil assignee is unchanged. From is not recorded;
+// LastTransition derives it from the entry before. Recording is best-effort:
+// a failure must never fail the change itself.
+func (b *Beads) logTransition(id, to string, assignee *string) {
+	if to == "" && assignee == nil {
+		return
+	}
+	data, err := json.Marshal(Transition{
+		Timestamp: currentTimestamp(),
+		IssueID:   id,
+		To:        to,
+		Assignee:  assignee,
+		Actor:     b.transitionActor(),
+	})
+	if err != nil {
+		return
+	}
+	path := filepath.Join(b.resolvedBeadsDir(), statusHistoryFile)
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600) //nolint:gosec // G304: path is constructed internally
+	if err != nil {
+		return
+	}
+	defer f.Close()
+	_, _ = f.Write(append(data, '\n'))
+}
+
+// transitionActor returns who to record for a change: the shared
+// ResolveActor chain, falling back to git user.email. Isolated wrappers
+// (tests) record no actor, as getActor does.
+func (b *Beads) transitionActor() string {
+	if b.isolated {
+		return ""
+	}
+	return ResolveActor("", GitActor)
+}
+
+// statusTransitions returns the recorded status changes for id, oldest first.
+func (b *Beads) statusTransitions(id string) ([]Transition, error) {
+	all, err := b.transitions(id)
+	if err != nil {
+		return nil, err
+	}
+	var transitions []Transition
+	for _, tr := range all {
+		if tr.To != "" {
+			transitions = append(transitions, tr)
+		}
+	}
+	return transitions, nil
+}
+
+// transitions returns every recorded change for id, oldest first.
+func (b *Beads) transitions(id string) ([]Transition, error) {
+	f, err := os.Open(filepath.Join(b.resolvedBeadsDir(), statusHistoryFile)) //nolint:gosec // G304: path is constructed internally
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("opening status history: %w", err)
+	}
+	defer f.Close()
+
+	var transitions []Transition
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var tr Transition
+		if err := json.Unmarshal(scanner.Bytes(), &tr); err != nil || tr.IssueID != id {
+			continue
+		}
+		transitions = append(transitions, tr)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading status history: %w", err)
+	}
+	return transitions, nil
+}
+
+// LastTransition returns the most recent status change of an issue: who
+// made it, from and to which status, and when. Changes made through gt are
+// read from the status history. If the issue's status no longer matches the
+// history (it was changed with bd directly), the change is reported from
+// the issue itself with no actor. An issue never changed reports its
+// creation.
+func (b *Beads) LastTransition(id string) (*Transition, error) {
+	issue, err := b.Show(id)
+	if err != nil {
+		return nil, err
+	}
+	transitions, err := b.statusTransitions(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if n := len(transitions); n > 0 {
+		last := transitions[n-1]
+		if n > 1 {
+			last.From = transitions[n-2].To
+		}
+		if last.To == issue.Status {
+			return &last, nil
+		}
+		// Changed outside gt since the last recorded transition
+		return &Transition{
+			Timestamp: changedAt(issue),
+			IssueID:   id,
+			From:      last.To,
+			To:        issue.Status,
+		}, nil
+	}
+
+	if issue.Status == "open" {
+		return &Transition{
+			Timestamp: issue.CreatedAt,
+			IssueID:   id,
+			To:        issue.Status,
+			Actor:     issue.CreatedBy,
+		}, nil
+	}
+	return &Transition{Timestamp: changedAt(issue), IssueID: id, To: issue.Status}, nil
+}
+
+// changedAt is the best available time for an unrecorded status change.
+func changedAt(issue *Issue) string {
+	if issue.Status == "closed" && issue.ClosedAt != "" {
+		return issue.ClosedAt
+	}
+	return issue.UpdatedAt
+}
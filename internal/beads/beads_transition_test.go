@@ -0,0 +1,169 @@
+package beads
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// installStatusStub serves gt-abc with the status last set by update,
+// close or reopen.
+func installStatusStub(t *testing.T) {
+	t.Helper()
+	installBDStub(t, `
+state="${BD_LOG}.status"
+[ -f "$state" ] || echo open > "$state"
+case "$cmd" in
+  show)
+    printf '[{"id":"gt-abc","status":"%s","created_at":"2026-01-05T09:00:00Z","created_by":"mayor","updated_at":"2026-01-05T13:00:00Z","closed_at":"2026-01-05T12:00:00Z"}]\n' "$(cat "$state")"
+    ;;
+  update)
+    for arg in "$@"; do
+      case "$arg" in --status=*) echo "${arg#--status=}" > "$state" ;; esac
+    done
+    ;;
+  close) echo closed > "$state" ;;
+  reopen) echo open > "$state" ;;
+esac
+`)
+}
+
+func newTransitionBeads(t *testing.T) *Beads {
+	t.Helper()
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, ".beads"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	return New(dir)
+}
+
+func TestLastTransitionIsMostRecent(t *testing.T) {
+	installStatusStub(t)
+	b := newTransitionBeads(t)
+
+	t.Setenv("BD_ACTOR", "gastown/polecats/Toast")
+	status := "in_progress"
+	if err := b.Update("gt-abc", UpdateOptions{Status: &status}); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	t.Setenv("BD_ACTOR", "gastown/witness")
+	if err := b.Close("gt-abc"); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	tr, err := b.LastTransition("gt-abc")
+	if err != nil {
+		t.Fatalf("LastTransition: %v", err)
+	}
+	if tr.From != "in_progress" || tr.To != "closed" || tr.Actor != "gastown/witness" {
+		t.Errorf("LastTransition = %+v, want in_progress → closed by gastown/witness", tr)
+	}
+	if tr.Timestamp == "" {
+		t.Error("LastTransition has no timestamp")
+	}
+
+	t.Setenv("BD_ACTOR", "mayor")
+	if err := b.ReopenWithReason("gt-abc", "not done"); err != nil {
+		t.Fatalf("ReopenWithReason: %v", err)
+	}
+	tr, err = b.LastTransition("gt-abc")
+	if err != nil {
+		t.Fatalf("LastTransition: %v", err)
+	}
+	if tr.From != "closed" || tr.To != "open" || tr.Actor != "mayor" {
+		t.Errorf("after reopen LastTransition = %+v, want closed → open by mayor", tr)
+	}
+}
+
+func TestLastTransitionChangedOutsideGT(t *testing.T) {
+	installStatusStub(t)
+	b := newTransitionBeads(t)
+
+	status := "in_progress"
+	if err := b.Update("gt-abc", UpdateOptions{Status: &status}); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	// Closed with bd directly: not in the status history
+	if _, err := b.run("close", "gt-abc"); err != nil {
+		t.Fatal(err)
+	}
+
+	tr, err := b.LastTransition("gt-abc")
+	if err != nil {
+		t.Fatalf("LastTransition: %v", err)
+	}
+	if tr.From != "in_progress" || tr.To != "closed" || tr.Actor != "" || tr.Timestamp != "2026-01-05T12:00:00Z" {
+		t.Errorf("LastTransition = %+v, want in_progress → closed at close time, no actor", tr)
+	}
+}
+
+func TestLastTransitionNeverChanged(t *testing.T) {
+	installStatusStub(t)
+	b := newTransitionBeads(t)
+
+	tr, err := b.LastTransition("gt-abc")
+	if err != nil {
+		t.Fatalf("LastTransition: %v", err)
+	}
+	if tr.To != "open" || tr.Actor != "mayor" || tr.Timestamp != "2026-01-05T09:00:00Z" {
+		t.Errorf("LastTransition = %+v, want creation by mayor", tr)
+	}
+}
+
+// redirectedWorktree creates a rig whose database is in mayor/rig and a
+// crew worktree whose .beads redirects there, returning both directories.
+func redirectedWorktree(t *testing.T) (worktree, rig string) {
+	t.Helper()
+	rigDir := t.TempDir()
+	rig = filepath.Join(rigDir, "mayor", "rig")
+	if err := os.MkdirAll(filepath.Join(rig, ".beads"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	worktree = filepath.Join(rigDir, "crew", "max")
+	if err := os.MkdirAll(filepath.Join(worktree, ".beads"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(worktree, ".beads", "redirect"), []byte("../../mayor/rig/.beads\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return worktree, rig
+}
+
+func TestLastTransitionFromRedirectedWorktree(t *testing.T) {
+	installStatusStub(t)
+	worktree, rig := redirectedWorktree(t)
+
+	t.Setenv("BD_ACTOR", "gastown/crew/max")
+	status := "in_progress"
+	if err := New(worktree).Update("gt-abc", UpdateOptions{Status: &status}); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+
+	tr, err := New(rig).LastTransition("gt-abc")
+	if err != nil {
+		t.Fatalf("LastTransition: %v", err)
+	}
+	if tr.To != "in_progress" || tr.Actor != "gastown/crew/max" {
+		t.Errorf("LastTransition from rig = %+v, want → in_progress by gastown/crew/max", tr)
+	}
+}
+
+func TestTransitionActorResolution(t *testing.T) {
+	installStatusStub(t)
+	b := newTransitionBeads(t)
+
+	// GT_ACTOR takes precedence over BD_ACTOR, as everywhere else gt
+	// records an actor
+	t.Setenv("GT_ACTOR", "gastown/crew/max")
+	t.Setenv("BD_ACTOR", "gastown/polecats/Toast")
+	if err := b.Close("gt-abc"); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	tr, err := b.LastTransition("gt-abc")
+	if err != nil {
+		t.Fatalf("LastTransition: %v", err)
+	}
+	if tr.Actor != "gastown/crew/max" {
+		t.Errorf("Actor = %q, want GT_ACTOR gastown/crew/max", tr.Actor)
+	}
+}
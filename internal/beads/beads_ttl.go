@@ -0,0 +1,83 @@
+// Package beads provides TTL-based expiry for ephemeral beads.
+package beads
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// LabelTTL marks an issue that was created with a TTL and should be
+// considered by ExpireEphemeral.
+const LabelTTL = "gt:ttl"
+
+// expiresLabelPrefix prefixes the label carrying an issue's expiry time.
+// The full label is "expires:<RFC3339 timestamp>".
+const expiresLabelPrefix = "expires:"
+
+// now returns the current time. Tests replace it to control expiry.
+var now = time.Now
+
+// expiresLabel formats the expiry label for the given deadline.
+func expiresLabel(deadline time.Time) string {
+	return expiresLabelPrefix + deadline.UTC().Format(time.RFC3339)
+}
+
+// ExpiresAt returns the expiry time recorded on an issue created with a TTL.
+// The second return value is false if the issue carries no expiry label
+// or the label cannot be parsed.
+func ExpiresAt(issue *Issue) (time.Time, bool) {
+	if issue == nil {
+		return time.Time{}, false
+	}
+	for _, label := range issue.Labels {
+		if !strings.HasPrefix(label, expiresLabelPrefix) {
+			continue
+		}
+		deadline, err := time.Parse(time.RFC3339, strings.TrimPrefix(label, expiresLabelPrefix))
+		if err != nil {
+			return time.Time{}, false
+		}
+		return deadline, true
+	}
+	return time.Time{}, false
+}
+
+// IsExpired reports whether an issue's TTL has elapsed as of the given time.
+// Issues without an expiry label never expire.
+func IsExpired(issue *Issue, at time.Time) bool {
+	deadline, ok := ExpiresAt(issue)
+	return ok && !at.Before(deadline)
+}
+
+// ExpireEphemeral closes open beads whose TTL has elapsed.
+// Returns the IDs of the beads that were closed. Beads that fail to close
+// are skipped and reported in the returned error; the rest are still closed.
+func (b *Beads) ExpireEphemeral() (expired []string, err error) {
+	issues, err := b.List(ListOptions{
+		Status:   "open",
+		Label:    LabelTTL,
+		Priority: -1,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing TTL beads: %w", err)
+	}
+
+	current := now()
+	var failed []string
+	for _, issue := range issues {
+		if !IsExpired(issue, current) {
+			continue
+		}
+		if err := b.CloseWithReason("expired (ttl)", issue.ID); err != nil {
+			failed = append(failed, fmt.Sprintf("%s: %v", issue.ID, err))
+			continue
+		}
+		expired = append(expired, issue.ID)
+	}
+
+	if len(failed) > 0 {
+		return expired, fmt.Errorf("expiring beads: %s", strings.Join(failed, "; "))
+	}
+	return expired, nil
+}
@@ -0,0 +1,68 @@
+package beads
+
+import (
+	"testing"
+	"time"
+)
+
+func TestExpiresAt(t *testing.T) {
+	deadline := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		issue  *Issue
+		wantOK bool
+	}{
+		{"nil issue", nil, false},
+		{"no labels", &Issue{ID: "gt-1"}, false},
+		{"ttl marker only", &Issue{ID: "gt-1", Labels: []string{LabelTTL}}, false},
+		{"malformed expiry", &Issue{ID: "gt-1", Labels: []string{"expires:tomorrow"}}, false},
+		{"valid expiry", &Issue{ID: "gt-1", Labels: []string{LabelTTL, expiresLabel(deadline)}}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := ExpiresAt(tt.issue)
+			if ok != tt.wantOK {
+				t.Fatalf("ExpiresAt() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if ok && !got.Equal(deadline) {
+				t.Errorf("ExpiresAt() = %v, want %v", got, deadline)
+			}
+		})
+	}
+}
+
+func TestIsExpired_AdvancingClock(t *testing.T) {
+	created := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	clock := created
+	origNow := now
+	now = func() time.Time { return clock }
+	defer func() { now = origNow }()
+
+	issue := &Issue{
+		ID:     "gt-wisp",
+		Labels: []string{LabelTTL, expiresLabel(now().Add(30 * time.Minute))},
+	}
+
+	if IsExpired(issue, now()) {
+		t.Fatal("issue expired at creation time")
+	}
+
+	clock = created.Add(29 * time.Minute)
+	if IsExpired(issue, now()) {
+		t.Error("issue expired before TTL elapsed")
+	}
+
+	clock = created.Add(31 * time.Minute)
+	if !IsExpired(issue, now()) {
+		t.Error("issue not expired after TTL elapsed")
+	}
+}
+
+func TestIsExpired_NoTTL(t *testing.T) {
+	issue := &Issue{ID: "gt-1", Labels: []string{"gt:task"}}
+	if IsExpired(issue, time.Now().Add(100*365*24*time.Hour)) {
+		t.Error("issue without TTL should never expire")
+	}
+}
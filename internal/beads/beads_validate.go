@@ -0,0 +1,88 @@
+// Package beads provides dry-run validation of create requests.
+package beads
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidCreate is returned by Validate, and by Create with DryRun set,
+// when a create request would fail or create a misrouted bead.
+var ErrInvalidCreate = errors.New("invalid create request")
+
+// Validate checks a create request without creating anything: the title is
+// set, the priority is in range, the labels fit the label taxonomy (b's, or
+// the default when none is set), and the parent exists in this database with
+// this database's prefix (bd gives a child its parent's ID, so a parent
+// routed to another rig cannot be used).
+// All problems are reported together.
+func (b *Beads) Validate(opts CreateOptions) error {
+	return b.validateCreate("", opts)
+}
+
+// ValidateWithID is Validate for CreateWithID. The ID's prefix must also
+// match this database's issue prefix.
+func (b *Beads) ValidateWithID(id string, opts CreateOptions) error {
+	return b.validateCreate(id, opts)
+}
+
+func (b *Beads) validateCreate(id string, opts CreateOptions) error {
+	var problems []string
+	if strings.TrimSpace(opts.Title) == "" {
+		problems = append(problems, "title is required")
+	}
+	if opts.Priority > 4 {
+		problems = append(problems, fmt.Sprintf("priority %d is out of range 0-4", opts.Priority))
+	}
+	taxonomy := b.labels
+	if taxonomy == nil {
+		taxonomy = DefaultLabelTaxonomy()
+	}
+	for _, issue := range taxonomy.Validate(createLabels(opts)) {
+		problems = append(problems, issue.String())
+	}
+
+	if id != "" || opts.Parent != "" {
+		prefix, err := b.GetConfig("issue_prefix")
+		if err != nil {
+			return fmt.Errorf("reading issue prefix: %w", err)
+		}
+		if id != "" {
+			if p := checkIDPrefix(id, prefix); p != "" {
+				problems = append(problems, p)
+			}
+		}
+		if opts.Parent != "" {
+			if p := checkIDPrefix(opts.Parent, prefix); p != "" {
+				problems = append(problems, "parent "+p)
+			} else if _, err := b.Show(opts.Parent); errors.Is(err, ErrNotFound) {
+				problems = append(problems, fmt.Sprintf("parent %s does not exist", opts.Parent))
+			} else if err != nil {
+				return fmt.Errorf("checking parent %s: %w", opts.Parent, err)
+			}
+		}
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("%w: %s", ErrInvalidCreate, strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// checkIDPrefix reports an ID whose prefix is not the database's issue
+// prefix, or "" if it matches. bd stores the prefix without its trailing
+// hyphen; an unset prefix matches anything.
+func checkIDPrefix(id, dbPrefix string) string {
+	if dbPrefix == "" {
+		return ""
+	}
+	prefix := ExtractPrefix(id)
+	if prefix == "" {
+		return fmt.Sprintf("%s has no prefix", id)
+	}
+	if strings.TrimSuffix(prefix, "-") != strings.TrimSuffix(dbPrefix, "-") {
+		return fmt.Sprintf("%s has prefix %q but this database uses %q (check routes.jsonl)", id, prefix, strings.TrimSuffix(dbPrefix, "-")+"-")
+	}
+	return ""
+}
@@ -0,0 +1,88 @@
+package beads
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+// validateStub is a database with prefix "gt" holding only gt-epic.
+const validateStub = `
+case "$cmd" in
+  config) echo gt ;;
+  show)
+    case "$1" in
+      gt-epic) printf '%s\n' '[{"id":"gt-epic","title":"Epic"}]' ;;
+      *) echo "Error: issue $1 not found" >&2; exit 1 ;;
+    esac
+    ;;
+  create) printf '%s\n' '{"id":"gt-new","title":"New"}' ;;
+esac
+`
+
+func TestValidate(t *testing.T) {
+	installBDStub(t, validateStub)
+	b := New(t.TempDir())
+
+	tests := []struct {
+		name    string
+		id      string
+		opts    CreateOptions
+		problem string // substring of the expected error; "" if valid
+	}{
+		{"valid", "", CreateOptions{Title: "Fix widget", Parent: "gt-epic", Priority: 2}, ""},
+		{"valid with id", "gt-abc", CreateOptions{Title: "Fix widget", Priority: -1}, ""},
+		{"missing title", "", CreateOptions{Title: "  ", Priority: -1}, "title is required"},
+		{"nonexistent parent", "", CreateOptions{Title: "Fix widget", Parent: "gt-nope", Priority: -1}, "parent gt-nope does not exist"},
+		{"parent in another rig", "", CreateOptions{Title: "Fix widget", Parent: "bd-epic", Priority: -1}, `parent bd-epic has prefix "bd-"`},
+		{"mismatched id prefix", "bd-abc", CreateOptions{Title: "Fix widget", Priority: -1}, `bd-abc has prefix "bd-" but this database uses "gt-"`},
+		{"priority out of range", "", CreateOptions{Title: "Fix widget", Priority: 7}, "priority 7"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var err error
+			if tt.id != "" {
+				err = b.ValidateWithID(tt.id, tt.opts)
+			} else {
+				err = b.Validate(tt.opts)
+			}
+			if tt.problem == "" {
+				if err != nil {
+					t.Errorf("Validate = %v, want nil", err)
+				}
+				return
+			}
+			if !errors.Is(err, ErrInvalidCreate) || !strings.Contains(err.Error(), tt.problem) {
+				t.Errorf("Validate = %v, want ErrInvalidCreate mentioning %q", err, tt.problem)
+			}
+		})
+	}
+}
+
+func TestValidateReportsAllProblems(t *testing.T) {
+	installBDStub(t, validateStub)
+	b := New(t.TempDir())
+
+	err := b.Validate(CreateOptions{Parent: "gt-nope", Priority: -1})
+	for _, want := range []string{"title is required", "parent gt-nope does not exist"} {
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("Validate = %v, want it to mention %q", err, want)
+		}
+	}
+}
+
+func TestCreateDryRun(t *testing.T) {
+	calls := installBDStub(t, validateStub)
+	b := New(t.TempDir())
+
+	issue, err := b.Create(CreateOptions{Title: "Fix widget", Parent: "gt-epic", Priority: -1, DryRun: true})
+	if err != nil || issue != nil {
+		t.Errorf("Create dry run = %v, %v; want nil, nil", issue, err)
+	}
+	if _, err := b.CreateWithID("bd-abc", CreateOptions{Title: "Fix widget", Priority: -1, DryRun: true}); !errors.Is(err, ErrInvalidCreate) {
+		t.Errorf("CreateWithID dry run = %v, want ErrInvalidCreate", err)
+	}
+	if hasCall(calls(), "create") {
+		t.Errorf("dry run created a bead: %v", calls())
+	}
+}
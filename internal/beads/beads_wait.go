@@ -0,0 +1,53 @@
+package beads
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrWaitTimeout is returned by WaitUntilReady when the issue is still
+// blocked at the deadline.
+var ErrWaitTimeout = errors.New("timed out waiting for issue to become ready")
+
+// readyPollInterval is how often WaitUntilReady rechecks a blocked issue.
+// It is a variable so tests can poll quickly.
+var readyPollInterval = 5 * time.Second
+
+// IsBlocked reports whether an issue (as returned by Show) has a blocking
+// dependency that is not yet closed.
+func IsBlocked(issue *Issue) bool {
+	for _, dep := range issue.Dependencies {
+		if dep.DependencyType == DepTypeBlocks && dep.Status != "closed" {
+			return true
+		}
+	}
+	return false
+}
+
+// WaitUntilReady blocks until every blocking dependency of the issue is
+// closed, or returns ErrWaitTimeout once timeout has passed. bd has no change
+// feed to subscribe to, so the issue is polled every readyPollInterval.
+// Waiting on an issue that is itself closed is an error, since it will never
+// become ready for work.
+func (b *Beads) WaitUntilReady(id string, timeout time.Duration) error {
+	deadline := now().Add(timeout)
+	for {
+		issue, err := b.Show(id)
+		if err != nil {
+			return fmt.Errorf("checking %s: %w", id, err)
+		}
+		if issue.Status == "closed" {
+			return fmt.Errorf("issue %s is closed", id)
+		}
+		if !IsBlocked(issue) {
+			return nil
+		}
+
+		remaining := deadline.Sub(now())
+		if remaining <= 0 {
+			return fmt.Errorf("%w: %s still blocked after %s", ErrWaitTimeout, id, timeout)
+		}
+		time.Sleep(min(readyPollInterval, remaining))
+	}
+}
@@ -0,0 +1,77 @@
+package beads
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// installBlockedStub serves gt-abc blocked by gt-blocker until the returned
+// file exists, standing in for the blocker being closed.
+func installBlockedStub(t *testing.T) string {
+	t.Helper()
+	closed := filepath.Join(t.TempDir(), "blocker-closed")
+	installBDStub(t, `
+case "$cmd" in
+  show)
+    status=open
+    [ -f "`+closed+`" ] && status=closed
+    printf '[{"id":"gt-abc","status":"open","dependencies":[{"id":"gt-blocker","status":"%s","dependency_type":"blocks"},{"id":"gt-ref","status":"open","dependency_type":"tracks"}]}]\n' "$status"
+    ;;
+esac
+`)
+	return closed
+}
+
+func TestWaitUntilReadyUnblocks(t *testing.T) {
+	closed := installBlockedStub(t)
+	origInterval := readyPollInterval
+	readyPollInterval = 10 * time.Millisecond
+	defer func() { readyPollInterval = origInterval }()
+
+	b := New(t.TempDir())
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		_ = os.WriteFile(closed, nil, 0644)
+	}()
+
+	start := time.Now()
+	if err := b.WaitUntilReady("gt-abc", 5*time.Second); err != nil {
+		t.Fatalf("WaitUntilReady: %v", err)
+	}
+	if waited := time.Since(start); waited < 50*time.Millisecond {
+		t.Errorf("returned after %s, before the blocker closed", waited)
+	}
+}
+
+func TestWaitUntilReadyTimeout(t *testing.T) {
+	installBlockedStub(t)
+	origInterval := readyPollInterval
+	readyPollInterval = 10 * time.Millisecond
+	defer func() { readyPollInterval = origInterval }()
+
+	b := New(t.TempDir())
+	err := b.WaitUntilReady("gt-abc", 50*time.Millisecond)
+	if !errors.Is(err, ErrWaitTimeout) {
+		t.Fatalf("WaitUntilReady = %v, want ErrWaitTimeout", err)
+	}
+	if !strings.Contains(err.Error(), "gt-abc") {
+		t.Errorf("error %q does not name the issue", err)
+	}
+}
+
+func TestWaitUntilReadyAlreadyReady(t *testing.T) {
+	closed := installBlockedStub(t)
+	if err := os.WriteFile(closed, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	// Non-blocking deps (tracks) do not hold the issue back.
+	b := New(t.TempDir())
+	if err := b.WaitUntilReady("gt-abc", 0); err != nil {
+		t.Errorf("WaitUntilReady = %v, want ready immediately", err)
+	}
+}
@@ -0,0 +1,75 @@
+// Package beads provides garbage collection for closed wisps.
+package beads
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// WispGCOptions controls which closed wisps WispGC reaps.
+type WispGCOptions struct {
+	// OlderThan only reaps wisps closed at least this long ago.
+	// Zero reaps every closed wisp.
+	OlderThan time.Duration
+
+	// DryRun reports the wisps that would be deleted without deleting them.
+	DryRun bool
+}
+
+// WispGC deletes closed wisps (ephemeral issues) that match opts.
+// Returns the IDs that were deleted, or that would be deleted in dry-run mode.
+// Wisps whose closed_at cannot be parsed are skipped when OlderThan is set,
+// since their age is unknown.
+func (b *Beads) WispGC(opts WispGCOptions) ([]string, error) {
+	out, err := b.run("list", "--status=closed", "--json", "--limit=0")
+	if err != nil {
+		return nil, err
+	}
+
+	var issues []*Issue
+	if err := json.Unmarshal(out, &issues); err != nil {
+		return nil, fmt.Errorf("parsing bd list output: %w", err)
+	}
+
+	candidates := selectWispsForGC(issues, opts.OlderThan, now())
+	if opts.DryRun {
+		return candidates, nil
+	}
+
+	var deleted, failed []string
+	for _, id := range candidates {
+		if _, err := b.run("delete", id, "--hard", "--force"); err != nil {
+			failed = append(failed, fmt.Sprintf("%s: %v", id, err))
+			continue
+		}
+		deleted = append(deleted, id)
+	}
+
+	if len(failed) > 0 {
+		return deleted, fmt.Errorf("deleting wisps: %s", strings.Join(failed, "; "))
+	}
+	return deleted, nil
+}
+
+// selectWispsForGC returns the IDs of closed wisps closed at least olderThan
+// before at. A zero olderThan selects every closed wisp.
+func selectWispsForGC(issues []*Issue, olderThan time.Duration, at time.Time) []string {
+	cutoff := at.Add(-olderThan)
+
+	var ids []string
+	for _, issue := range issues {
+		if !issue.Ephemeral || issue.Status != "closed" {
+			continue
+		}
+		if olderThan > 0 {
+			closedAt, err := time.Parse(time.RFC3339, issue.ClosedAt)
+			if err != nil || closedAt.After(cutoff) {
+				continue
+			}
+		}
+		ids = append(ids, issue.ID)
+	}
+	return ids
+}
@@ -0,0 +1,37 @@
+package beads
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestSelectWispsForGC(t *testing.T) {
+	at := time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC)
+	issues := []*Issue{
+		{ID: "gt-old", Status: "closed", Ephemeral: true, ClosedAt: at.Add(-48 * time.Hour).Format(time.RFC3339)},
+		{ID: "gt-new", Status: "closed", Ephemeral: true, ClosedAt: at.Add(-10 * time.Minute).Format(time.RFC3339)},
+		{ID: "gt-open", Status: "open", Ephemeral: true},
+		{ID: "gt-durable", Status: "closed", ClosedAt: at.Add(-48 * time.Hour).Format(time.RFC3339)},
+		{ID: "gt-noclose", Status: "closed", Ephemeral: true},
+	}
+
+	tests := []struct {
+		name      string
+		olderThan time.Duration
+		want      []string
+	}{
+		{"no threshold reaps all closed wisps", 0, []string{"gt-old", "gt-new", "gt-noclose"}},
+		{"threshold keeps recent wisps", 24 * time.Hour, []string{"gt-old"}},
+		{"threshold beyond all wisps", 72 * time.Hour, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := selectWispsForGC(issues, tt.olderThan, at)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("selectWispsForGC() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
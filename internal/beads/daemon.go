@@ -2,9 +2,11 @@ package beads
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"os/exec"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"time"
@@ -38,12 +40,22 @@ type BdDaemonHealth struct {
 // CheckBdDaemonHealth checks the health of all bd daemons.
 // Returns nil if no daemons are running (which is fine, bd will use direct mode).
 func CheckBdDaemonHealth() (*BdDaemonHealth, error) {
-	cmd := exec.Command("bd", "daemon", "health", "--json")
+	return checkBdDaemonHealth(context.Background())
+}
+
+// checkBdDaemonHealth is CheckBdDaemonHealth bounded by ctx: if ctx ends
+// first, bd is killed and waited for, and ctx's error is returned.
+func checkBdDaemonHealth(ctx context.Context) (*BdDaemonHealth, error) {
+	cmd := exec.CommandContext(ctx, "bd", "daemon", "health", "--json")
+	cmd.WaitDelay = time.Second
 	var stdout, stderr bytes.Buffer
 	cmd.Stdout = &stdout
 	cmd.Stderr = &stderr
 
 	err := cmd.Run()
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return nil, fmt.Errorf("bd daemon health: %w", ctxErr)
+	}
 	if err != nil {
 		// bd daemon health may fail if bd not installed or other issues
 		// Return nil to indicate we can't check (not an error for status display)
@@ -129,6 +141,74 @@ func StartBdDaemonIfNeeded(workDir string) error {
 	return cmd.Run()
 }
 
+// daemonRestartTimeout bounds each wait in RestartBdDaemon: for the old
+// daemon to exit, and for the new one to report healthy.
+var daemonRestartTimeout = 5 * time.Second
+
+// RestartBdDaemon restarts the bd daemon serving workDir. Unlike
+// restartBdDaemons, which signals every daemon and relies on auto-start, it
+// waits for the old PID to disappear from bd daemon health before starting,
+// then verifies the new daemon reports healthy under a different PID.
+func RestartBdDaemon(workDir string) error {
+	old, err := workspaceDaemon(workDir)
+	if err != nil {
+		return err
+	}
+
+	if old != nil {
+		stop := exec.Command("bd", "daemon", "stop")
+		stop.Dir = workDir
+		if err := stop.Run(); err != nil {
+			return fmt.Errorf("stopping bd daemon: %w", err)
+		}
+		if !waitForDaemon(workDir, func(d *BdDaemonInfo) bool { return d == nil || d.PID != old.PID }) {
+			return fmt.Errorf("bd daemon (pid %d) did not exit within %s", old.PID, daemonRestartTimeout)
+		}
+	}
+
+	if err := StartBdDaemonIfNeeded(workDir); err != nil {
+		return fmt.Errorf("starting bd daemon: %w", err)
+	}
+	if !waitForDaemon(workDir, func(d *BdDaemonInfo) bool {
+		return d != nil && d.Status == "healthy" && (old == nil || d.PID != old.PID)
+	}) {
+		return fmt.Errorf("bd daemon did not report healthy within %s", daemonRestartTimeout)
+	}
+	return nil
+}
+
+// workspaceDaemon returns the daemon bd daemon health reports for workDir,
+// or nil if none is running there.
+func workspaceDaemon(workDir string) (*BdDaemonInfo, error) {
+	health, err := CheckBdDaemonHealth()
+	if err != nil || health == nil {
+		return nil, err
+	}
+	want, _ := filepath.Abs(workDir)
+	for i := range health.Daemons {
+		if ws, _ := filepath.Abs(health.Daemons[i].Workspace); ws == want {
+			return &health.Daemons[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// waitForDaemon polls workDir's daemon until done reports true or
+// daemonRestartTimeout elapses.
+func waitForDaemon(workDir string, done func(*BdDaemonInfo) bool) bool {
+	deadline := time.Now().Add(daemonRestartTimeout)
+	for {
+		d, err := workspaceDaemon(workDir)
+		if err == nil && done(d) {
+			return true
+		}
+		if time.Now().After(deadline) {
+			return false
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+}
+
 // StopAllBdProcesses stops all bd daemon and activity processes.
 // Returns (daemonsKilled, activityKilled, error).
 // If dryRun is true, returns counts without stopping anything.
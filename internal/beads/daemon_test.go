@@ -1,8 +1,11 @@
 package beads
 
 import (
+	"os"
 	"os/exec"
+	"path/filepath"
 	"testing"
+	"time"
 )
 
 func TestCountBdActivityProcesses(t *testing.T) {
@@ -31,3 +34,60 @@ func TestStopAllBdProcesses_DryRun(t *testing.T) {
 		t.Errorf("counts should be non-negative: daemons=%d, activity=%d", daemonsKilled, activityKilled)
 	}
 }
+
+// installDaemonStub fakes bd daemon health/stop/start for workDir, keeping
+// the running daemon's PID in a state file. Returns the state file path.
+func installDaemonStub(t *testing.T, workDir, pid string) string {
+	t.Helper()
+	state := filepath.Join(t.TempDir(), "pid")
+	if err := os.WriteFile(state, []byte(pid), 0644); err != nil {
+		t.Fatal(err)
+	}
+	installBDStub(t, `STATE="`+state+`"
+pid=$(cat "$STATE")
+case "$cmd $1" in
+  "daemon health")
+    if [ -n "$pid" ]; then
+      printf '{"total":1,"healthy":1,"daemons":[{"workspace":"%s","pid":%s,"status":"healthy"}]}\n' "`+workDir+`" "$pid"
+    else
+      echo '{"total":0,"daemons":[]}'
+    fi
+    ;;
+  "daemon stop") : > "$STATE" ;;
+  "daemon start") echo 5678 > "$STATE" ;;
+esac`)
+	return state
+}
+
+func TestRestartBdDaemon(t *testing.T) {
+	workDir := t.TempDir()
+	installDaemonStub(t, workDir, "1234")
+
+	if err := RestartBdDaemon(workDir); err != nil {
+		t.Fatalf("RestartBdDaemon: %v", err)
+	}
+	d, err := workspaceDaemon(workDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d == nil || d.PID != 5678 || d.Status != "healthy" {
+		t.Errorf("daemon after restart = %+v, want healthy with pid 5678", d)
+	}
+}
+
+func TestRestartBdDaemon_StopNeverCompletes(t *testing.T) {
+	workDir := t.TempDir()
+	installBDStub(t, `
+case "$cmd $1" in
+  "daemon health")
+    printf '{"total":1,"healthy":1,"daemons":[{"workspace":"%s","pid":1234,"status":"healthy"}]}\n' "`+workDir+`"
+    ;;
+esac`)
+	orig := daemonRestartTimeout
+	daemonRestartTimeout = 200 * time.Millisecond
+	t.Cleanup(func() { daemonRestartTimeout = orig })
+
+	if err := RestartBdDaemon(workDir); err == nil {
+		t.Fatal("expected error when the old daemon never exits")
+	}
+}
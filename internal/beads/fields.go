@@ -3,7 +3,10 @@ package beads
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 )
 
 // Note: AgentFields, ParseAgentFields, FormatAgentDescription, and CreateAgentBead are in beads.go
@@ -21,10 +24,110 @@ type AttachmentFields struct {
 	AttachedAt       string // ISO 8601 timestamp when attached
 	AttachedArgs     string // Natural language args passed via gt sling --args (no-tmux mode)
 	DispatchedBy     string // Agent ID that dispatched this work (for completion notification)
+	AttachedFormula  string // Formula the wisp was poured from (wisp roots only)
+	GuidesBead       string // Bead the wisp was bonded to and guides (wisp roots only)
+	ScheduledTarget  string // Sling target for a scheduled dispatch (gt sling --at)
+}
+
+// attachmentKeys maps every accepted spelling of an attachment field key
+// (lowercase) to its canonical name.
+var attachmentKeys = map[string]string{
+	"attached_molecule": "attached_molecule",
+	"attached-molecule": "attached_molecule",
+	"attachedmolecule":  "attached_molecule",
+	"attached_at":       "attached_at",
+	"attached-at":       "attached_at",
+	"attachedat":        "attached_at",
+	"attached_args":     "attached_args",
+	"attached-args":     "attached_args",
+	"attachedargs":      "attached_args",
+	"dispatched_by":     "dispatched_by",
+	"dispatched-by":     "dispatched_by",
+	"dispatchedby":      "dispatched_by",
+	"attached_formula":  "attached_formula",
+	"attached-formula":  "attached_formula",
+	"attachedformula":   "attached_formula",
+	"guides_bead":       "guides_bead",
+	"guides-bead":       "guides_bead",
+	"guidesbead":        "guides_bead",
+	"scheduled_target":  "scheduled_target",
+	"scheduled-target":  "scheduled_target",
+	"scheduledtarget":   "scheduled_target",
+}
+
+// parseAttachmentLine splits a "key: value" line and reports whether the key
+// is a known attachment field. The returned key is canonical.
+func parseAttachmentLine(line string) (key, value string, ok bool) {
+	line = strings.TrimSpace(line)
+	colonIdx := strings.Index(line, ":")
+	if colonIdx == -1 {
+		return "", "", false
+	}
+	key, ok = attachmentKeys[strings.ToLower(strings.TrimSpace(line[:colonIdx]))]
+	if !ok {
+		return "", "", false
+	}
+	return key, decodeAttachmentValue(strings.TrimSpace(line[colonIdx+1:])), true
+}
+
+// splitAttachmentHeader separates the attachment header from the freeform
+// text of a description. The header is the run of attachment field lines at
+// the very top; a single blank line after it is treated as the separator.
+// Field-like lines anywhere else belong to the prose and are never parsed,
+// so user-edited text cannot inject or clobber attachment fields.
+func splitAttachmentHeader(description string) (header []string, prose string) {
+	if description == "" {
+		return nil, ""
+	}
+
+	lines := strings.Split(description, "\n")
+	n := 0
+	for n < len(lines) {
+		if _, _, ok := parseAttachmentLine(lines[n]); !ok {
+			break
+		}
+		n++
+	}
+	if n == 0 {
+		return nil, description
+	}
+
+	rest := lines[n:]
+	if len(rest) > 0 && strings.TrimSpace(rest[0]) == "" {
+		rest = rest[1:]
+	}
+	return lines[:n], strings.Join(rest, "\n")
+}
+
+// encodeAttachmentValue quotes a value that would not survive a plain
+// "key: value" line: embedded newlines or other control characters,
+// surrounding whitespace, or a leading quote. Plain values are left as-is.
+func encodeAttachmentValue(value string) string {
+	needsQuote := value != strings.TrimSpace(value) ||
+		strings.HasPrefix(value, `"`) ||
+		!utf8.ValidString(value) ||
+		strings.IndexFunc(value, unicode.IsControl) != -1
+	if needsQuote {
+		return strconv.Quote(value)
+	}
+	return value
+}
+
+// decodeAttachmentValue reverses encodeAttachmentValue. Values that look
+// quoted but fail to unquote are kept verbatim for compatibility with
+// hand-written descriptions.
+func decodeAttachmentValue(value string) string {
+	if strings.HasPrefix(value, `"`) {
+		if unquoted, err := strconv.Unquote(value); err == nil {
+			return unquoted
+		}
+	}
+	return value
 }
 
 // ParseAttachmentFields extracts attachment fields from an issue's description.
-// Fields are expected as "key: value" lines. Returns nil if no attachment fields found.
+// Fields are expected as "key: value" lines at the top of the description.
+// Returns nil if no attachment fields found.
 func ParseAttachmentFields(issue *Issue) *AttachmentFields {
 	if issue == nil || issue.Description == "" {
 		return nil
@@ -33,39 +136,30 @@ func ParseAttachmentFields(issue *Issue) *AttachmentFields {
 	fields := &AttachmentFields{}
 	hasFields := false
 
-	for _, line := range strings.Split(issue.Description, "\n") {
-		line = strings.TrimSpace(line)
-		if line == "" {
-			continue
-		}
-
-		// Look for "key: value" pattern
-		colonIdx := strings.Index(line, ":")
-		if colonIdx == -1 {
-			continue
-		}
-
-		key := strings.TrimSpace(line[:colonIdx])
-		value := strings.TrimSpace(line[colonIdx+1:])
+	header, _ := splitAttachmentHeader(issue.Description)
+	for _, line := range header {
+		key, value, _ := parseAttachmentLine(line)
 		if value == "" {
 			continue
 		}
 
-		// Map keys to fields (case-insensitive)
-		switch strings.ToLower(key) {
-		case "attached_molecule", "attached-molecule", "attachedmolecule":
+		switch key {
+		case "attached_molecule":
 			fields.AttachedMolecule = value
-			hasFields = true
-		case "attached_at", "attached-at", "attachedat":
+		case "attached_at":
 			fields.AttachedAt = value
-			hasFields = true
-		case "attached_args", "attached-args", "attachedargs":
+		case "attached_args":
 			fields.AttachedArgs = value
-			hasFields = true
-		case "dispatched_by", "dispatched-by", "dispatchedby":
+		case "dispatched_by":
 			fields.DispatchedBy = value
-			hasFields = true
+		case "attached_formula":
+			fields.AttachedFormula = value
+		case "guides_bead":
+			fields.GuidesBead = value
+		case "scheduled_target":
+			fields.ScheduledTarget = value
 		}
+		hasFields = true
 	}
 
 	if !hasFields {
@@ -84,87 +178,47 @@ func FormatAttachmentFields(fields *AttachmentFields) string {
 	var lines []string
 
 	if fields.AttachedMolecule != "" {
-		lines = append(lines, "attached_molecule: "+fields.AttachedMolecule)
+		lines = append(lines, "attached_molecule: "+encodeAttachmentValue(fields.AttachedMolecule))
 	}
 	if fields.AttachedAt != "" {
-		lines = append(lines, "attached_at: "+fields.AttachedAt)
+		lines = append(lines, "attached_at: "+encodeAttachmentValue(fields.AttachedAt))
 	}
 	if fields.AttachedArgs != "" {
-		lines = append(lines, "attached_args: "+fields.AttachedArgs)
+		lines = append(lines, "attached_args: "+encodeAttachmentValue(fields.AttachedArgs))
 	}
 	if fields.DispatchedBy != "" {
-		lines = append(lines, "dispatched_by: "+fields.DispatchedBy)
+		lines = append(lines, "dispatched_by: "+encodeAttachmentValue(fields.DispatchedBy))
+	}
+	if fields.AttachedFormula != "" {
+		lines = append(lines, "attached_formula: "+encodeAttachmentValue(fields.AttachedFormula))
+	}
+	if fields.GuidesBead != "" {
+		lines = append(lines, "guides_bead: "+encodeAttachmentValue(fields.GuidesBead))
+	}
+	if fields.ScheduledTarget != "" {
+		lines = append(lines, "scheduled_target: "+encodeAttachmentValue(fields.ScheduledTarget))
 	}
 
 	return strings.Join(lines, "\n")
 }
 
 // SetAttachmentFields updates an issue's description with the given attachment fields.
-// Existing attachment field lines are replaced; other content is preserved.
-// Returns the new description string.
+// The existing attachment header is replaced; the freeform text after it is
+// preserved byte-for-byte. Returns the new description string.
 func SetAttachmentFields(issue *Issue, fields *AttachmentFields) string {
-	// Known attachment field keys (lowercase)
-	attachmentKeys := map[string]bool{
-		"attached_molecule": true,
-		"attached-molecule": true,
-		"attachedmolecule":  true,
-		"attached_at":       true,
-		"attached-at":       true,
-		"attachedat":        true,
-		"attached_args":     true,
-		"attached-args":     true,
-		"attachedargs":      true,
-		"dispatched_by":     true,
-		"dispatched-by":     true,
-		"dispatchedby":      true,
-	}
-
-	// Collect non-attachment lines from existing description
-	var otherLines []string
-	if issue != nil && issue.Description != "" {
-		for _, line := range strings.Split(issue.Description, "\n") {
-			trimmed := strings.TrimSpace(line)
-			if trimmed == "" {
-				// Preserve blank lines in content
-				otherLines = append(otherLines, line)
-				continue
-			}
-
-			// Check if this is an attachment field line
-			colonIdx := strings.Index(trimmed, ":")
-			if colonIdx == -1 {
-				otherLines = append(otherLines, line)
-				continue
-			}
-
-			key := strings.ToLower(strings.TrimSpace(trimmed[:colonIdx]))
-			if !attachmentKeys[key] {
-				otherLines = append(otherLines, line)
-			}
-			// Skip attachment field lines - they'll be replaced
-		}
+	var prose string
+	if issue != nil {
+		_, prose = splitAttachmentHeader(issue.Description)
 	}
 
-	// Build new description: attachment fields first, then other content
 	formatted := FormatAttachmentFields(fields)
-
-	// Trim trailing blank lines from other content
-	for len(otherLines) > 0 && strings.TrimSpace(otherLines[len(otherLines)-1]) == "" {
-		otherLines = otherLines[:len(otherLines)-1]
-	}
-	// Trim leading blank lines from other content
-	for len(otherLines) > 0 && strings.TrimSpace(otherLines[0]) == "" {
-		otherLines = otherLines[1:]
-	}
-
 	if formatted == "" {
-		return strings.Join(otherLines, "\n")
+		return prose
 	}
-	if len(otherLines) == 0 {
+	if prose == "" {
 		return formatted
 	}
-
-	return formatted + "\n\n" + strings.Join(otherLines, "\n")
+	return formatted + "\n\n" + prose
 }
 
 // MRFields holds the structured fields for a merge-request issue.
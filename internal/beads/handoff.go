@@ -217,6 +217,114 @@ func (b *Beads) GetAttachment(pinnedBeadID string) (*AttachmentFields, error) {
 	return ParseAttachmentFields(issue), nil
 }
 
+// updateAttachmentFields fetches a bead, applies mutate to its attachment
+// fields, and writes the description back. Non-attachment description
+// content is preserved.
+func (b *Beads) updateAttachmentFields(id string, mutate func(*AttachmentFields)) error {
+	issue, err := b.Show(id)
+	if err != nil {
+		return fmt.Errorf("fetching bead: %w", err)
+	}
+
+	fields := ParseAttachmentFields(issue)
+	if fields == nil {
+		fields = &AttachmentFields{}
+	}
+	mutate(fields)
+
+	newDesc := SetAttachmentFields(issue, fields)
+	if err := b.Update(id, UpdateOptions{Description: &newDesc}); err != nil {
+		return fmt.Errorf("updating bead description: %w", err)
+	}
+	return nil
+}
+
+// GetAttachedMolecule returns the attached_molecule field of a bead.
+// Returns empty string if no molecule is attached.
+func (b *Beads) GetAttachedMolecule(id string) (string, error) {
+	fields, err := b.GetAttachment(id)
+	if err != nil || fields == nil {
+		return "", err
+	}
+	return fields.AttachedMolecule, nil
+}
+
+// SetAttachedMolecule records moleculeID as the bead's attached molecule.
+// Unlike AttachMolecule, the bead does not need to be pinned. attached_at is
+// set on first attachment and preserved afterwards. An empty moleculeID is a
+// no-op.
+func (b *Beads) SetAttachedMolecule(id, moleculeID string) error {
+	if moleculeID == "" {
+		return nil
+	}
+	return b.updateAttachmentFields(id, func(f *AttachmentFields) {
+		f.AttachedMolecule = moleculeID
+		if f.AttachedAt == "" {
+			f.AttachedAt = currentTimestamp()
+		}
+	})
+}
+
+// GetDispatchedBy returns the agent that dispatched a bead, or empty string.
+func (b *Beads) GetDispatchedBy(id string) (string, error) {
+	fields, err := b.GetAttachment(id)
+	if err != nil || fields == nil {
+		return "", err
+	}
+	return fields.DispatchedBy, nil
+}
+
+// SetDispatchedBy records the agent that dispatched a bead, enabling
+// completion notification back to the dispatcher. An unknown (empty)
+// dispatcher is a no-op.
+func (b *Beads) SetDispatchedBy(id, dispatcher string) error {
+	if dispatcher == "" {
+		return nil
+	}
+	return b.updateAttachmentFields(id, func(f *AttachmentFields) {
+		f.DispatchedBy = dispatcher
+	})
+}
+
+// SetArgs stores natural-language args (gt sling --args) on a bead so agents
+// can discover them via gt prime / bd show in no-tmux mode.
+func (b *Beads) SetArgs(id, args string) error {
+	return b.updateAttachmentFields(id, func(f *AttachmentFields) {
+		f.AttachedArgs = args
+	})
+}
+
+// WispLineage records where a wisp came from: the formula it was poured
+// from and, for formula-on-bead slings, the bead it was bonded to.
+type WispLineage struct {
+	WispID      string `json:"wisp_id"`
+	FormulaName string `json:"formula"`
+	GuidesBead  string `json:"guides_bead,omitempty"`
+}
+
+// SetWispLineage records a wisp root's formula and guided bead (empty for
+// a bare formula sling) alongside its other attachment fields.
+func (b *Beads) SetWispLineage(wispID, formulaName, guidesBead string) error {
+	return b.updateAttachmentFields(wispID, func(f *AttachmentFields) {
+		f.AttachedFormula = formulaName
+		f.GuidesBead = guidesBead
+	})
+}
+
+// WispLineage returns the lineage recorded on a wisp root by
+// SetWispLineage. Returns nil if the wisp has no recorded formula.
+func (b *Beads) WispLineage(wispID string) (*WispLineage, error) {
+	fields, err := b.GetAttachment(wispID)
+	if err != nil || fields == nil || fields.AttachedFormula == "" {
+		return nil, err
+	}
+	return &WispLineage{
+		WispID:      wispID,
+		FormulaName: fields.AttachedFormula,
+		GuidesBead:  fields.GuidesBead,
+	}, nil
+}
+
 // currentTimestamp returns the current time in ISO 8601 format.
 func currentTimestamp() string {
 	return time.Now().UTC().Format(time.RFC3339)
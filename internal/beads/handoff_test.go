@@ -0,0 +1,165 @@
+package beads
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+)
+
+// attachmentStub answers bd show with a bead carrying a dispatcher and prose,
+// and saves the description passed to bd update in $BD_LOG.desc.
+const attachmentStub = `
+case "$cmd" in
+  show)
+    printf '%s\n' '[{"id":"gt-abc","title":"Work","status":"open","description":"dispatched_by: mayor/\n\nFix the widget."}]'
+    ;;
+  update)
+    for arg in "$@"; do
+      case "$arg" in
+        --description=*) printf '%s' "${arg#--description=}" > "${BD_LOG}.desc" ;;
+      esac
+    done
+    ;;
+esac
+`
+
+func TestAttachmentAccessors(t *testing.T) {
+	installBDStub(t, attachmentStub)
+	b := New(t.TempDir())
+
+	dispatcher, err := b.GetDispatchedBy("gt-abc")
+	if err != nil {
+		t.Fatalf("GetDispatchedBy: %v", err)
+	}
+	if dispatcher != "mayor/" {
+		t.Errorf("GetDispatchedBy = %q, want mayor/", dispatcher)
+	}
+
+	mol, err := b.GetAttachedMolecule("gt-abc")
+	if err != nil {
+		t.Fatalf("GetAttachedMolecule: %v", err)
+	}
+	if mol != "" {
+		t.Errorf("GetAttachedMolecule = %q, want empty", mol)
+	}
+}
+
+func TestSetArgsPreservesOtherFields(t *testing.T) {
+	installBDStub(t, attachmentStub)
+	b := New(t.TempDir())
+
+	if err := b.SetArgs("gt-abc", "patch release"); err != nil {
+		t.Fatalf("SetArgs: %v", err)
+	}
+
+	desc := readUpdatedDescription(t)
+	fields := ParseAttachmentFields(&Issue{Description: desc})
+	if fields == nil {
+		t.Fatalf("no attachment fields in updated description %q", desc)
+	}
+	if fields.AttachedArgs != "patch release" {
+		t.Errorf("AttachedArgs = %q, want 'patch release'", fields.AttachedArgs)
+	}
+	if fields.DispatchedBy != "mayor/" {
+		t.Errorf("DispatchedBy = %q, want preserved mayor/", fields.DispatchedBy)
+	}
+	if !strings.Contains(desc, "Fix the widget.") {
+		t.Errorf("prose lost from description %q", desc)
+	}
+}
+
+func TestSetAttachedMoleculeStampsAttachedAt(t *testing.T) {
+	installBDStub(t, attachmentStub)
+	b := New(t.TempDir())
+
+	if err := b.SetAttachedMolecule("gt-abc", "gt-wisp-1"); err != nil {
+		t.Fatalf("SetAttachedMolecule: %v", err)
+	}
+
+	fields := ParseAttachmentFields(&Issue{Description: readUpdatedDescription(t)})
+	if fields == nil || fields.AttachedMolecule != "gt-wisp-1" {
+		t.Fatalf("AttachedMolecule not set: %+v", fields)
+	}
+	if fields.AttachedAt == "" {
+		t.Error("AttachedAt not stamped on first attachment")
+	}
+}
+
+func TestSetAttachmentEmptyValueIsNoOp(t *testing.T) {
+	calls := installBDStub(t, attachmentStub)
+	b := New(t.TempDir())
+
+	if err := b.SetDispatchedBy("gt-abc", ""); err != nil {
+		t.Fatalf("SetDispatchedBy: %v", err)
+	}
+	if err := b.SetAttachedMolecule("gt-abc", ""); err != nil {
+		t.Fatalf("SetAttachedMolecule: %v", err)
+	}
+	if got := calls(); len(got) != 0 {
+		t.Errorf("empty values ran bd: %v", got)
+	}
+}
+
+// readUpdatedDescription returns the description captured by attachmentStub.
+func readUpdatedDescription(t *testing.T) string {
+	t.Helper()
+	data, err := os.ReadFile(os.Getenv("BD_LOG") + ".desc")
+	if err != nil {
+		t.Fatalf("no bd update captured: %v", err)
+	}
+	return string(data)
+}
+
+func TestWispLineageRoundTrip(t *testing.T) {
+	// show serves whatever JSON the test last wrote to $BD_LOG.show
+	installBDStub(t, `
+case "$cmd" in
+  show) cat "${BD_LOG}.show" ;;
+  update)
+    for arg in "$@"; do
+      case "$arg" in
+        --description=*) printf '%s' "${arg#--description=}" > "${BD_LOG}.desc" ;;
+      esac
+    done
+    ;;
+esac
+`)
+	serve := func(desc string) {
+		t.Helper()
+		data, err := json.Marshal([]*Issue{{ID: "gt-wisp-1", Status: "hooked", Description: desc}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(os.Getenv("BD_LOG")+".show", data, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	b := New(t.TempDir())
+
+	serve("attached_molecule: gt-wisp-1\n\nWork the steps.")
+	if lineage, err := b.WispLineage("gt-wisp-1"); err != nil || lineage != nil {
+		t.Fatalf("WispLineage before recording = %+v, %v; want nil", lineage, err)
+	}
+
+	if err := b.SetWispLineage("gt-wisp-1", "mol-polecat-work", "gt-123"); err != nil {
+		t.Fatalf("SetWispLineage: %v", err)
+	}
+	desc := readUpdatedDescription(t)
+	serve(desc)
+
+	lineage, err := b.WispLineage("gt-wisp-1")
+	if err != nil {
+		t.Fatalf("WispLineage: %v", err)
+	}
+	want := WispLineage{WispID: "gt-wisp-1", FormulaName: "mol-polecat-work", GuidesBead: "gt-123"}
+	if lineage == nil || *lineage != want {
+		t.Errorf("WispLineage = %+v, want %+v", lineage, want)
+	}
+	if mol, _ := b.GetAttachedMolecule("gt-wisp-1"); mol != "gt-wisp-1" {
+		t.Errorf("attached_molecule = %q, want preserved gt-wisp-1", mol)
+	}
+	if !strings.Contains(desc, "Work the steps.") {
+		t.Errorf("prose lost from description %q", desc)
+	}
+}
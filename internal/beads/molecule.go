@@ -18,6 +18,7 @@ type MoleculeStep struct {
 	Tier         string         // Optional tier hint: haiku, sonnet, opus
 	Type         string         // Step type: "task" (default), "wait", etc.
 	Backoff      *BackoffConfig // Backoff configuration for wait-type steps
+	Condition    string         // Optional branch condition (see Condition)
 }
 
 // BackoffConfig defines exponential backoff parameters for wait-type steps.
@@ -49,6 +50,10 @@ var typeLineRegex = regexp.MustCompile(`(?i)^Type:\s*(\w+)\s*$`)
 // Parses backoff configuration for wait-type steps.
 var backoffLineRegex = regexp.MustCompile(`(?i)^Backoff:\s*(.+)$`)
 
+// conditionLineRegex matches "Condition: <expr>" lines.
+// The step runs only when the expression holds (see ParseCondition).
+var conditionLineRegex = regexp.MustCompile(`(?i)^Condition:\s*(.+)$`)
+
 // templateVarRegex matches {{variable}} placeholders.
 var templateVarRegex = regexp.MustCompile(`\{\{(\w+)\}\}`)
 
@@ -62,6 +67,7 @@ var templateVarRegex = regexp.MustCompile(`\{\{(\w+)\}\}`)
 //	Tier: haiku|sonnet|opus  # optional
 //	Type: task|wait  # optional, default is "task"
 //	Backoff: base=30s, multiplier=2, max=10m  # optional, for wait-type steps
+//	Condition: tests.label == failed  # optional, step is skipped unless it holds
 //
 // Returns an empty slice if no steps are found.
 func ParseMoleculeSteps(description string) ([]MoleculeStep, error) {
@@ -127,6 +133,12 @@ func ParseMoleculeSteps(description string) ([]MoleculeStep, error) {
 				continue
 			}
 
+			// Check for Condition: line
+			if matches := conditionLineRegex.FindStringSubmatch(trimmed); matches != nil {
+				currentStep.Condition = strings.TrimSpace(matches[1])
+				continue
+			}
+
 			// Regular instruction line
 			instructionLines = append(instructionLines, line)
 		}
@@ -406,6 +418,9 @@ func (b *Beads) instantiateFromMarkdown(mol *Issue, parent *Issue, opts Instanti
 		if step.Tier != "" {
 			description += fmt.Sprintf("\ntier: %s", step.Tier)
 		}
+		if step.Condition != "" {
+			description += fmt.Sprintf("\ncondition: %s", step.Condition)
+		}
 
 		// Create the child issue
 		childOpts := CreateOptions{
@@ -498,6 +513,11 @@ func ValidateMolecule(mol *Issue) error {
 				return fmt.Errorf("step %q has self-dependency", step.Ref)
 			}
 		}
+		if step.Condition != "" {
+			if _, err := ParseCondition(step.Condition); err != nil {
+				return fmt.Errorf("step %q condition: %w", step.Ref, err)
+			}
+		}
 	}
 
 	// Detect cycles in dependency graph
@@ -571,3 +591,16 @@ func detectCycles(steps []MoleculeStep) error {
 func formatCycle(cycle []string) string {
 	return strings.Join(cycle, " -> ")
 }
+
+// MolBondTracks attaches a wisp to a base bead with a non-blocking tracks
+// relation and returns the wisp.
+//
+// bd mol bond creates a blocking edge, which hides the base bead from bd ready
+// until the wisp closes. Use this instead when the molecule is guidance for
+// working the bead (the "attached molecule" pattern) rather than a prerequisite.
+func (b *Beads) MolBondTracks(wispID, beadID string) (*Issue, error) {
+	if err := b.AddTypedDependency(wispID, beadID, DepTypeTracks); err != nil {
+		return nil, fmt.Errorf("bonding %s to %s: %w", wispID, beadID, err)
+	}
+	return b.Show(wispID)
+}
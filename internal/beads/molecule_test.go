@@ -787,3 +787,31 @@ Needs: step1`
 		t.Errorf("step[1].Type = %q, want task", steps[1].Type)
 	}
 }
+
+// TestMolBondTracks verifies the wisp is bonded with a non-blocking tracks
+// edge rather than bd mol bond, so the base bead stays in bd ready.
+func TestMolBondTracks(t *testing.T) {
+	calls := installBDStub(t, `
+case "$cmd" in
+  dep) exit 0 ;;
+  show) echo '[{"id":"gt-wisp-1","title":"mol-polecat-work","status":"open"}]' ;;
+esac
+`)
+
+	b := New(t.TempDir())
+	wisp, err := b.MolBondTracks("gt-wisp-1", "gt-base")
+	if err != nil {
+		t.Fatalf("MolBondTracks: %v", err)
+	}
+	if wisp.ID != "gt-wisp-1" {
+		t.Errorf("returned issue = %q, want gt-wisp-1", wisp.ID)
+	}
+
+	log := calls()
+	if !hasCall(log, "dep add gt-wisp-1 gt-base --type=tracks") {
+		t.Errorf("expected tracks dep add, got %v", log)
+	}
+	if hasCall(log, "mol bond") {
+		t.Errorf("MolBondTracks must not use blocking mol bond, got %v", log)
+	}
+}
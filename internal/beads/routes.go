@@ -8,6 +8,8 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/steveyegge/gastown/internal/config"
 )
@@ -242,10 +244,13 @@ func GetRigPathForPrefix(townRoot, prefix string) string {
 // Since bd update doesn't support routing or redirects, we must resolve the
 // actual rig directory from the bead's prefix. hookWorkDir is only used as
 // a fallback if prefix resolution fails.
+//
+// Prefix lookups are cached per town and invalidated whenever routes.jsonl
+// changes, since this runs on nearly every sling.
 func ResolveHookDir(townRoot, beadID, hookWorkDir string) string {
 	// Always try prefix resolution first - bd update needs the actual rig dir
 	prefix := ExtractPrefix(beadID)
-	if rigPath := GetRigPathForPrefix(townRoot, prefix); rigPath != "" {
+	if rigPath := hookDirs.lookup(townRoot, prefix); rigPath != "" {
 		return rigPath
 	}
 	// Fallback to hookWorkDir if provided
@@ -254,3 +259,46 @@ func ResolveHookDir(townRoot, beadID, hookWorkDir string) string {
 	}
 	return townRoot
 }
+
+// hookDirCache memoizes GetRigPathForPrefix results for ResolveHookDir.
+// Entries for a town are dropped when its routes.jsonl mtime or size changes.
+type hookDirCache struct {
+	mu    sync.Mutex
+	towns map[string]*townHookDirs
+}
+
+type townHookDirs struct {
+	modTime time.Time
+	size    int64
+	paths   map[string]string // prefix -> rig path ("" if unrouted)
+}
+
+var hookDirs = &hookDirCache{towns: make(map[string]*townHookDirs)}
+
+// lookup returns the rig path for prefix in townRoot, consulting the cache.
+func (c *hookDirCache) lookup(townRoot, prefix string) string {
+	info, err := os.Stat(filepath.Join(townRoot, ".beads", RoutesFileName))
+	if err != nil {
+		return GetRigPathForPrefix(townRoot, prefix)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	town := c.towns[townRoot]
+	if town == nil || !town.modTime.Equal(info.ModTime()) || town.size != info.Size() {
+		town = &townHookDirs{
+			modTime: info.ModTime(),
+			size:    info.Size(),
+			paths:   make(map[string]string),
+		}
+		c.towns[townRoot] = town
+	}
+
+	if path, ok := town.paths[prefix]; ok {
+		return path
+	}
+	path := GetRigPathForPrefix(townRoot, prefix)
+	town.paths[prefix] = path
+	return path
+}
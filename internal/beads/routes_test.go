@@ -218,6 +218,29 @@ func TestResolveHookDir(t *testing.T) {
 	}
 }
 
+func TestResolveHookDir_CacheInvalidatedOnRoutesChange(t *testing.T) {
+	tmpDir := t.TempDir()
+	beadsDir := filepath.Join(tmpDir, ".beads")
+	if err := os.MkdirAll(beadsDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	routesPath := filepath.Join(beadsDir, "routes.jsonl")
+
+	if err := os.WriteFile(routesPath, []byte(`{"prefix": "ap-", "path": "old/rig"}`+"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := ResolveHookDir(tmpDir, "ap-1", ""), filepath.Join(tmpDir, "old/rig"); got != want {
+		t.Fatalf("ResolveHookDir() = %q, want %q", got, want)
+	}
+
+	if err := os.WriteFile(routesPath, []byte(`{"prefix": "ap-", "path": "new/platform/rig"}`+"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := ResolveHookDir(tmpDir, "ap-1", ""), filepath.Join(tmpDir, "new/platform/rig"); got != want {
+		t.Errorf("ResolveHookDir() after routes change = %q, want %q", got, want)
+	}
+}
+
 func TestAgentBeadIDsWithPrefix(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -99,7 +99,7 @@ func runActivityEmit(cmd *cobra.Command, args []string) error {
 	// Auto-detect actor if not provided
 	actor := activityActor
 	if actor == "" {
-		actor = detectActor()
+		actor = ResolveActor("")
 	}
 
 	// Build payload based on event type
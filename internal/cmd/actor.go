@@ -0,0 +1,27 @@
+package cmd
+
+import (
+	"github.com/steveyegge/gastown/internal/beads"
+)
+
+// actorFromRole and actorFromGit are the role- and git-based actor sources
+// used by ResolveActor. They are variables so tests can stub them.
+var (
+	actorFromRole = func() string {
+		roleInfo, err := GetRole()
+		if err != nil || roleInfo.Role == RoleUnknown || roleInfo.Role == "" {
+			return ""
+		}
+		return roleInfo.ActorString()
+	}
+	actorFromGit = beads.GitActor
+)
+
+// ResolveActor returns the identity to record for events, audit entries and
+// created beads. It is beads.ResolveActor with the detected Gas Town role
+// tried before git user.email: the explicit override, the GT_ACTOR and
+// BD_ACTOR environment variables, the role, and git user.email, in order.
+// "unknown" is returned only when all of them are empty.
+func ResolveActor(override string) string {
+	return beads.ResolveActor(override, actorFromRole, actorFromGit)
+}
@@ -0,0 +1,45 @@
+package cmd
+
+import "testing"
+
+func stubActorSources(t *testing.T, role, git string) {
+	t.Helper()
+	origRole, origGit := actorFromRole, actorFromGit
+	actorFromRole = func() string { return role }
+	actorFromGit = func() string { return git }
+	t.Cleanup(func() {
+		actorFromRole, actorFromGit = origRole, origGit
+	})
+}
+
+func TestResolveActor(t *testing.T) {
+	tests := []struct {
+		name     string
+		override string
+		gtActor  string
+		bdActor  string
+		role     string
+		git      string
+		want     string
+	}{
+		{"override wins", "explicit", "gt", "bd", "mayor", "me@example.com", "explicit"},
+		{"GT_ACTOR", "", "gt", "bd", "mayor", "me@example.com", "gt"},
+		{"BD_ACTOR", "", "", "bd", "mayor", "me@example.com", "bd"},
+		{"role", "", "", "", "gastown/witness", "me@example.com", "gastown/witness"},
+		{"git email", "", "", "", "", "me@example.com", "me@example.com"},
+		{"unknown", "", "", "", "", "", "unknown"},
+		{"blank override ignored", "  ", "", "", "", "me@example.com", "me@example.com"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("GT_ACTOR", tt.gtActor)
+			t.Setenv("BD_ACTOR", tt.bdActor)
+			stubActorSources(t, tt.role, tt.git)
+
+			if got := ResolveActor(tt.override); got != tt.want {
+				t.Errorf("ResolveActor(%q) = %q, want %q", tt.override, got, tt.want)
+			}
+		})
+	}
+}
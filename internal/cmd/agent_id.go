@@ -0,0 +1,91 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/session"
+)
+
+// AgentID is a parsed slash-delimited agent address:
+//   - mayor, deacon (town-level; a trailing slash is accepted)
+//   - <rig>/witness, <rig>/refinery
+//   - <rig>/crew/<name>, <rig>/polecats/<name>
+type AgentID struct {
+	role session.Role
+	rig  string
+	name string
+}
+
+// ParseAgentID parses an agent address such as "gastown/polecats/Toast".
+func ParseAgentID(s string) (AgentID, error) {
+	switch strings.TrimSuffix(s, "/") {
+	case "mayor":
+		return AgentID{role: session.RoleMayor}, nil
+	case "deacon":
+		return AgentID{role: session.RoleDeacon}, nil
+	}
+
+	parts := strings.Split(s, "/")
+	for _, p := range parts {
+		if p == "" {
+			return AgentID{}, fmt.Errorf("invalid agent ID %q: empty segment", s)
+		}
+	}
+
+	switch {
+	case len(parts) == 2 && parts[1] == "witness":
+		return AgentID{role: session.RoleWitness, rig: parts[0]}, nil
+	case len(parts) == 2 && parts[1] == "refinery":
+		return AgentID{role: session.RoleRefinery, rig: parts[0]}, nil
+	case len(parts) == 3 && parts[1] == "crew":
+		return AgentID{role: session.RoleCrew, rig: parts[0], name: parts[2]}, nil
+	case len(parts) == 3 && parts[1] == "polecats":
+		return AgentID{role: session.RolePolecat, rig: parts[0], name: parts[2]}, nil
+	default:
+		return AgentID{}, fmt.Errorf("invalid agent ID %q: unknown agent shape", s)
+	}
+}
+
+// Role returns the agent's role.
+func (a AgentID) Role() session.Role { return a.role }
+
+// Rig returns the agent's rig, or "" for town-level agents.
+func (a AgentID) Rig() string { return a.rig }
+
+// Name returns the crew or polecat name, or "" for singleton roles.
+func (a AgentID) Name() string { return a.name }
+
+// IsPolecat reports whether the agent is a polecat.
+func (a AgentID) IsPolecat() bool { return a.role == session.RolePolecat }
+
+// String returns the canonical address, e.g. "gastown/polecats/Toast".
+func (a AgentID) String() string {
+	return (&session.AgentIdentity{Role: a.role, Rig: a.rig, Name: a.name}).Address()
+}
+
+// BeadID returns the agent's bead ID. Town-level agents use the hq- prefix;
+// rig agents use the rig's prefix from the town's routes.
+func (a AgentID) BeadID(townRoot string) string {
+	switch a.role {
+	case session.RoleMayor:
+		return beads.MayorBeadIDTown()
+	case session.RoleDeacon:
+		return beads.DeaconBeadIDTown()
+	}
+
+	prefix := beads.GetPrefixForRig(townRoot, a.rig)
+	switch a.role {
+	case session.RoleWitness:
+		return beads.WitnessBeadIDWithPrefix(prefix, a.rig)
+	case session.RoleRefinery:
+		return beads.RefineryBeadIDWithPrefix(prefix, a.rig)
+	case session.RoleCrew:
+		return beads.CrewBeadIDWithPrefix(prefix, a.rig, a.name)
+	case session.RolePolecat:
+		return beads.PolecatBeadIDWithPrefix(prefix, a.rig, a.name)
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/session"
+)
+
+func TestParseAgentID(t *testing.T) {
+	townRoot := t.TempDir()
+	beadsDir := filepath.Join(townRoot, ".beads")
+	if err := os.MkdirAll(beadsDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	routes := `{"prefix":"bd-","path":"beads/mayor/rig"}` + "\n"
+	if err := os.WriteFile(filepath.Join(beadsDir, "routes.jsonl"), []byte(routes), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		in      string
+		role    session.Role
+		rig     string
+		name    string
+		polecat bool
+		addr    string
+		beadID  string
+	}{
+		{in: "mayor", role: session.RoleMayor, addr: "mayor", beadID: "hq-mayor"},
+		{in: "mayor/", role: session.RoleMayor, addr: "mayor", beadID: "hq-mayor"},
+		{in: "deacon", role: session.RoleDeacon, addr: "deacon", beadID: "hq-deacon"},
+		{in: "deacon/", role: session.RoleDeacon, addr: "deacon", beadID: "hq-deacon"},
+		{in: "gastown/witness", role: session.RoleWitness, rig: "gastown", addr: "gastown/witness", beadID: "gt-gastown-witness"},
+		{in: "gastown/refinery", role: session.RoleRefinery, rig: "gastown", addr: "gastown/refinery", beadID: "gt-gastown-refinery"},
+		{in: "gastown/crew/max", role: session.RoleCrew, rig: "gastown", name: "max", addr: "gastown/crew/max", beadID: "gt-gastown-crew-max"},
+		{in: "gastown/polecats/Toast", role: session.RolePolecat, rig: "gastown", name: "Toast", polecat: true, addr: "gastown/polecats/Toast", beadID: "gt-gastown-polecat-Toast"},
+		{in: "beads/polecats/pearl", role: session.RolePolecat, rig: "beads", name: "pearl", polecat: true, addr: "beads/polecats/pearl", beadID: "bd-beads-polecat-pearl"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			id, err := ParseAgentID(tt.in)
+			if err != nil {
+				t.Fatalf("ParseAgentID(%q): %v", tt.in, err)
+			}
+			if id.Role() != tt.role || id.Rig() != tt.rig || id.Name() != tt.name {
+				t.Errorf("parsed (%s, %q, %q), want (%s, %q, %q)", id.Role(), id.Rig(), id.Name(), tt.role, tt.rig, tt.name)
+			}
+			if id.IsPolecat() != tt.polecat {
+				t.Errorf("IsPolecat = %v, want %v", id.IsPolecat(), tt.polecat)
+			}
+			if got := id.String(); got != tt.addr {
+				t.Errorf("String = %q, want %q", got, tt.addr)
+			}
+			if got := id.BeadID(townRoot); got != tt.beadID {
+				t.Errorf("BeadID = %q, want %q", got, tt.beadID)
+			}
+		})
+	}
+}
+
+func TestParseAgentIDMalformed(t *testing.T) {
+	for _, in := range []string{
+		"",
+		"/",
+		"gastown",
+		"gastown/",
+		"/witness",
+		"gastown/polecats",
+		"gastown/polecats/",
+		"gastown/crew",
+		"gastown//Toast",
+		"gastown/dogs/alpha",
+		"gastown/polecats/Toast/extra",
+		"gastown/witness/extra",
+		"mayor/extra",
+	} {
+		if id, err := ParseAgentID(in); err == nil {
+			t.Errorf("ParseAgentID(%q) = %+v, want error", in, id)
+		}
+		if got := agentIDToBeadID(in, t.TempDir()); got != "" {
+			t.Errorf("agentIDToBeadID(%q) = %q, want empty", in, got)
+		}
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
 )
 
 var beadCmd = &cobra.Command{
@@ -57,10 +58,34 @@ Examples:
 	},
 }
 
+var beadGraphCmd = &cobra.Command{
+	Use:   "graph <bead-id>",
+	Short: "Export the dependency graph around a bead",
+	Long: `Export the dependencies and dependents of a bead as Graphviz DOT.
+
+Closed beads are greyed out. Edges that close a dependency cycle are drawn
+dashed in red.
+
+Examples:
+  gt bead graph gt-epic | dot -Tsvg > epic.svg
+  gt bead graph gt-epic --depth 2
+  gt bead graph gt-epic --json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runBeadGraph,
+}
+
+var (
+	beadGraphDepth int
+	beadGraphJSON  bool
+)
+
 func init() {
 	beadMoveCmd.Flags().BoolVarP(&beadMoveDryRun, "dry-run", "n", false, "Show what would be done")
+	beadGraphCmd.Flags().IntVar(&beadGraphDepth, "depth", -1, "Maximum hops from the bead (-1 for unlimited)")
+	beadGraphCmd.Flags().BoolVar(&beadGraphJSON, "json", false, "Output as JSON instead of DOT")
 	beadCmd.AddCommand(beadMoveCmd)
 	beadCmd.AddCommand(beadShowCmd)
+	beadCmd.AddCommand(beadGraphCmd)
 	rootCmd.AddCommand(beadCmd)
 }
 
@@ -165,3 +190,25 @@ func runBeadMove(cmd *cobra.Command, args []string) error {
 
 	return nil
 }
+
+func runBeadGraph(cmd *cobra.Command, args []string) error {
+	beadID := args[0]
+
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	cwd, _ := os.Getwd()
+
+	graph, err := beadsForBead(townRoot, beadID, cwd).DependencyGraph(beadID, beadGraphDepth)
+	if err != nil {
+		return fmt.Errorf("building graph for %s: %w", beadID, err)
+	}
+
+	if beadGraphJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(graph)
+	}
+	return graph.WriteDOT(os.Stdout)
+}
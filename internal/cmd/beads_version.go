@@ -131,26 +131,47 @@ func CheckBeadsVersion() error {
 	return cachedVersionCheckResult
 }
 
+// beadsInstallHint is the setup guidance shown when bd is missing or too old.
+const beadsInstallHint = "Install beads: go install github.com/steveyegge/beads/cmd/bd@latest\n" +
+	"(and make sure $(go env GOPATH)/bin is on your PATH)"
+
 func checkBeadsVersionInternal() error {
+	// Catch a missing bd here, once, rather than as ErrNotInstalled from
+	// whichever bd call a command happens to make first.
+	if _, err := exec.LookPath("bd"); err != nil {
+		return fmt.Errorf("beads (bd) is not installed or not on PATH; Gas Town needs bd %s or newer\n\n%s", MinBeadsVersion, beadsInstallHint)
+	}
+
+	return RequireMinVersion(MinBeadsVersion)
+}
+
+// RequireMinVersion returns an error unless the installed bd is at least
+// min. Gas Town relies on bd's JSON output shapes, so an older bd fails here
+// with upgrade instructions rather than later with a parse error.
+func RequireMinVersion(min string) error {
 	installedStr, err := getBeadsVersion()
 	if err != nil {
 		return fmt.Errorf("cannot verify beads version: %w", err)
 	}
+	return checkMinVersion(installedStr, min)
+}
 
+// checkMinVersion compares an installed bd version against min. Pre-release
+// suffixes ("0.44.0-dev", "1.0.0-double") count as their release version.
+func checkMinVersion(installedStr, min string) error {
 	installed, err := parseBeadsVersion(installedStr)
 	if err != nil {
 		return fmt.Errorf("cannot parse installed beads version %q: %w", installedStr, err)
 	}
 
-	required, err := parseBeadsVersion(MinBeadsVersion)
+	required, err := parseBeadsVersion(min)
 	if err != nil {
 		// This would be a bug in our code
-		return fmt.Errorf("cannot parse required beads version %q: %w", MinBeadsVersion, err)
+		return fmt.Errorf("cannot parse required beads version %q: %w", min, err)
 	}
 
 	if installed.compare(required) < 0 {
-		return fmt.Errorf("beads version %s is required, but %s is installed\n\nPlease upgrade beads: go install github.com/steveyegge/beads/cmd/bd@latest", MinBeadsVersion, installedStr)
+		return fmt.Errorf("beads %s is installed; upgrade bd to >= %s\n\n%s", installedStr, min, beadsInstallHint)
 	}
-
 	return nil
 }
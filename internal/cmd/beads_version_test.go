@@ -1,6 +1,11 @@
 package cmd
 
-import "testing"
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
 
 func TestParseBeadsVersion(t *testing.T) {
 	tests := []struct {
@@ -66,3 +71,56 @@ func TestBeadsVersionCompare(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckBeadsVersionNotInstalled(t *testing.T) {
+	t.Setenv("PATH", t.TempDir())
+
+	err := checkBeadsVersionInternal()
+	if err == nil {
+		t.Fatal("expected error when bd is not on PATH")
+	}
+	for _, want := range []string{"not installed", MinBeadsVersion, "go install github.com/steveyegge/beads/cmd/bd@latest"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error %q missing %q", err, want)
+		}
+	}
+}
+
+func TestCheckBeadsVersionTooOld(t *testing.T) {
+	binDir := t.TempDir()
+	script := "#!/bin/sh\necho 'bd version 0.1.0'\n"
+	if err := os.WriteFile(filepath.Join(binDir, "bd"), []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PATH", binDir)
+
+	err := checkBeadsVersionInternal()
+	if err == nil || !strings.Contains(err.Error(), "0.1.0 is installed") || !strings.Contains(err.Error(), "go install") {
+		t.Errorf("err = %v, want upgrade guidance", err)
+	}
+}
+
+func TestCheckMinVersion(t *testing.T) {
+	tests := []struct {
+		installed string
+		wantErr   bool
+	}{
+		{"0.43.9", true},  // below min
+		{"0.44.0", false}, // at min
+		{"0.45.2", false}, // above min
+		{"0.44.0-dev", false},
+		{"1.0.0-double", false},
+		{"0.43.0-double", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.installed, func(t *testing.T) {
+			err := checkMinVersion(tt.installed, "0.44.0")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("checkMinVersion(%q) error = %v, wantErr %v", tt.installed, err, tt.wantErr)
+			}
+			if err != nil && !strings.Contains(err.Error(), "upgrade bd to >= 0.44.0") {
+				t.Errorf("error %q lacks upgrade guidance", err)
+			}
+		})
+	}
+}
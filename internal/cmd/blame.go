@@ -0,0 +1,70 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var blameJSON bool
+
+var blameCmd = &cobra.Command{
+	Use:     "blame <bead-id>",
+	GroupID: GroupDiag,
+	Short:   "Show who made a bead's last status change",
+	Long: `Show the most recent status change of a bead: who made it, from and to
+which status, and when.
+
+Changes made through gt are recorded with their actor (BD_ACTOR). A change
+made with bd directly shows no actor. Use this to answer "who closed this?".
+For the full story, see 'gt history'.
+
+Examples:
+  gt blame gt-abc
+  gt blame gt-abc --json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runBlame,
+}
+
+func init() {
+	blameCmd.Flags().BoolVar(&blameJSON, "json", false, "Output as JSON")
+	rootCmd.AddCommand(blameCmd)
+}
+
+func runBlame(cmd *cobra.Command, args []string) error {
+	beadID := args[0]
+
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	cwd, _ := os.Getwd()
+
+	tr, err := beadsForBead(townRoot, beadID, cwd).LastTransition(beadID)
+	if err != nil {
+		return fmt.Errorf("getting last transition for %s: %w", beadID, err)
+	}
+
+	if blameJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(tr)
+	}
+
+	from := tr.From
+	if from == "" {
+		from = "?"
+	}
+	actor := tr.Actor
+	if actor == "" {
+		actor = "unknown"
+	}
+	fmt.Printf("%s %s → %s\n", style.Bold.Render(beadID), from, style.Bold.Render(tr.To))
+	fmt.Printf("  By:   %s\n", actor)
+	fmt.Printf("  When: %s\n", tr.Timestamp)
+	return nil
+}
@@ -1,12 +1,21 @@
 package cmd
 
 import (
+	"fmt"
 	"os"
 	"os/exec"
 
 	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/mail"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
 )
 
+// notifyDispatcherFlag makes gt close mail each closed bead's dispatcher.
+// Flag parsing is disabled for the bd passthrough, so it is picked out of
+// the args by hand and not forwarded to bd.
+const notifyDispatcherFlag = "--notify-dispatcher"
+
 var closeCmd = &cobra.Command{
 	Use:     "close [bead-id...]",
 	GroupID: GroupWork,
@@ -16,11 +25,15 @@ var closeCmd = &cobra.Command{
 This is a convenience command that passes through to 'bd close' with
 all arguments and flags preserved.
 
+With --notify-dispatcher, each closed bead's dispatcher (the agent that
+slung it, recorded as dispatched_by) is sent a WORK_DONE mail.
+
 Examples:
   gt close gt-abc              # Close bead gt-abc
   gt close gt-abc gt-def       # Close multiple beads
   gt close --reason "Done"     # Close with reason
-  gt close --force             # Force close pinned beads`,
+  gt close --force             # Force close pinned beads
+  gt close gt-abc --notify-dispatcher  # Close and tell the dispatcher`,
 	DisableFlagParsing: true, // Pass all flags through to bd close
 	RunE:               runClose,
 }
@@ -30,11 +43,77 @@ func init() {
 }
 
 func runClose(cmd *cobra.Command, args []string) error {
+	var passthrough []string
+	notify := false
+	for _, arg := range args {
+		if arg == notifyDispatcherFlag {
+			notify = true
+			continue
+		}
+		passthrough = append(passthrough, arg)
+	}
+
 	// Build bd close command with all args passed through
-	bdArgs := append([]string{"close"}, args...)
+	bdArgs := append([]string{"close"}, passthrough...)
 	bdCmd := exec.Command("bd", bdArgs...)
 	bdCmd.Stdin = os.Stdin
 	bdCmd.Stdout = os.Stdout
 	bdCmd.Stderr = os.Stderr
-	return bdCmd.Run()
+	if err := bdCmd.Run(); err != nil {
+		return err
+	}
+
+	if notify {
+		notifyCloseDispatchers(passthrough)
+	}
+	return nil
+}
+
+// notifyCloseDispatchers mails the dispatchers of the beads named in args.
+// Failures are warnings: the beads are already closed.
+func notifyCloseDispatchers(args []string) {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		style.PrintWarning("not notifying dispatchers: %v", err)
+		return
+	}
+
+	lookup := func(beadID string) (string, error) {
+		return beadsForBead(townRoot, beadID, "").GetDispatchedBy(beadID)
+	}
+	router := mail.NewRouter(townRoot)
+	for _, msg := range closeNotifications(args, detectSender(), lookup) {
+		if err := router.Send(msg); err != nil {
+			style.PrintWarning("could not notify dispatcher %s: %v", msg.To, err)
+			continue
+		}
+		fmt.Printf("%s Dispatcher %s notified\n", style.Bold.Render("✓"), msg.To)
+	}
+}
+
+// closeNotifications builds a WORK_DONE mail, as gt done sends, for each
+// bead ID in args whose dispatcher is known and is not the sender. Other
+// args (flags and their values) are skipped.
+func closeNotifications(args []string, sender string, dispatcherOf func(beadID string) (string, error)) []*mail.Message {
+	var msgs []*mail.Message
+	for _, arg := range args {
+		if !looksLikeBeadID(arg) {
+			continue
+		}
+		dispatcher, err := dispatcherOf(arg)
+		if err != nil {
+			style.PrintWarning("could not read dispatcher of %s: %v", arg, err)
+			continue
+		}
+		if dispatcher == "" || dispatcher == sender {
+			continue
+		}
+		msgs = append(msgs, &mail.Message{
+			To:      dispatcher,
+			From:    sender,
+			Subject: fmt.Sprintf("WORK_DONE: %s", arg),
+			Body:    fmt.Sprintf("%s was closed by %s.", arg, sender),
+		})
+	}
+	return msgs
 }
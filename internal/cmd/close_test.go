@@ -0,0 +1,32 @@
+package cmd
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCloseNotifications(t *testing.T) {
+	dispatchers := map[string]string{
+		"gt-abc": "mayor/",
+		"gt-def": "",
+		"gt-own": "gastown/crew/max",
+	}
+	lookup := func(beadID string) (string, error) {
+		d, ok := dispatchers[beadID]
+		if !ok {
+			return "", errors.New("not found")
+		}
+		return d, nil
+	}
+
+	args := []string{"gt-abc", "--reason", "Done", "gt-def", "gt-own", "gt-missing"}
+	msgs := closeNotifications(args, "gastown/crew/max", lookup)
+
+	if len(msgs) != 1 {
+		t.Fatalf("got %d messages, want 1: %+v", len(msgs), msgs)
+	}
+	msg := msgs[0]
+	if msg.To != "mayor/" || msg.From != "gastown/crew/max" || msg.Subject != "WORK_DONE: gt-abc" {
+		t.Errorf("message = %+v, want WORK_DONE: gt-abc to mayor/ from gastown/crew/max", msg)
+	}
+}
@@ -26,6 +26,8 @@ var (
 	costsWeek    bool
 	costsByRole  bool
 	costsByRig   bool
+	costsBySess  bool
+	costsByDay   bool
 	costsVerbose bool
 
 	// Record subcommand flags
@@ -67,6 +69,8 @@ Examples:
   gt costs --week       # This week's costs from digest beads + today's wisps
   gt costs --by-role    # Breakdown by role (polecat, witness, etc.)
   gt costs --by-rig     # Breakdown by rig
+  gt costs --by-session # Breakdown by session
+  gt costs --by-day     # Breakdown by day
   gt costs --json       # Output as JSON
 
 Subcommands:
@@ -140,6 +144,8 @@ func init() {
 	costsCmd.Flags().BoolVar(&costsWeek, "week", false, "Show this week's total from session events")
 	costsCmd.Flags().BoolVar(&costsByRole, "by-role", false, "Show breakdown by role")
 	costsCmd.Flags().BoolVar(&costsByRig, "by-rig", false, "Show breakdown by rig")
+	costsCmd.Flags().BoolVar(&costsBySess, "by-session", false, "Show breakdown by session")
+	costsCmd.Flags().BoolVar(&costsByDay, "by-day", false, "Show breakdown by day")
 	costsCmd.Flags().BoolVarP(&costsVerbose, "verbose", "v", false, "Show debug output for failures")
 
 	// Add record subcommand
@@ -186,15 +192,42 @@ type CostsOutput struct {
 	Total    float64            `json:"total_usd"`
 	ByRole   map[string]float64 `json:"by_role,omitempty"`
 	ByRig    map[string]float64 `json:"by_rig,omitempty"`
+	BySess   map[string]float64 `json:"by_session,omitempty"`
+	ByDay    map[string]float64 `json:"by_day,omitempty"`
 	Period   string             `json:"period,omitempty"`
 }
 
+// sumCostsBy totals entry costs grouped by key. Entries with an empty key
+// are left out of the breakdown (they still count toward the overall total).
+func sumCostsBy(entries []CostEntry, key func(CostEntry) string) map[string]float64 {
+	sums := make(map[string]float64)
+	for _, entry := range entries {
+		if k := key(entry); k != "" {
+			sums[k] += entry.CostUSD
+		}
+	}
+	return sums
+}
+
+// costEntryDay returns the YYYY-MM-DD day an entry is attributed to:
+// when the session ended, or when it started if the end is unknown.
+func costEntryDay(entry CostEntry) string {
+	t := entry.EndedAt
+	if t.IsZero() {
+		t = entry.StartedAt
+	}
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format("2006-01-02")
+}
+
 // costRegex matches cost patterns like "$1.23" or "$12.34"
 var costRegex = regexp.MustCompile(`\$(\d+\.\d{2})`)
 
 func runCosts(cmd *cobra.Command, args []string) error {
 	// If querying ledger, use ledger functions
-	if costsToday || costsWeek || costsByRole || costsByRig {
+	if costsToday || costsWeek || costsByRole || costsByRig || costsBySess || costsByDay {
 		return runCostsFromLedger()
 	}
 
@@ -307,15 +340,8 @@ func runCostsFromLedger() error {
 
 	// Calculate totals
 	var total float64
-	byRole := make(map[string]float64)
-	byRig := make(map[string]float64)
-
 	for _, entry := range entries {
 		total += entry.CostUSD
-		byRole[entry.Role] += entry.CostUSD
-		if entry.Rig != "" {
-			byRig[entry.Rig] += entry.CostUSD
-		}
 	}
 
 	// Build output
@@ -324,10 +350,16 @@ func runCostsFromLedger() error {
 	}
 
 	if costsByRole {
-		output.ByRole = byRole
+		output.ByRole = sumCostsBy(entries, func(e CostEntry) string { return e.Role })
 	}
 	if costsByRig {
-		output.ByRig = byRig
+		output.ByRig = sumCostsBy(entries, func(e CostEntry) string { return e.Rig })
+	}
+	if costsBySess {
+		output.BySess = sumCostsBy(entries, func(e CostEntry) string { return e.SessionID })
+	}
+	if costsByDay {
+		output.ByDay = sumCostsBy(entries, costEntryDay)
 	}
 
 	// Set period label
@@ -732,6 +764,27 @@ func outputLedgerHuman(output CostsOutput, entries []CostEntry) error {
 		}
 	}
 
+	// By session breakdown
+	if len(output.BySess) > 0 {
+		fmt.Printf("\n%s\n", style.Bold.Render("By Session:"))
+		for session, cost := range output.BySess {
+			fmt.Printf("  %-30s $%.2f\n", session, cost)
+		}
+	}
+
+	// By day breakdown, oldest first
+	if len(output.ByDay) > 0 {
+		fmt.Printf("\n%s\n", style.Bold.Render("By Day:"))
+		days := make([]string, 0, len(output.ByDay))
+		for day := range output.ByDay {
+			days = append(days, day)
+		}
+		sort.Strings(days)
+		for _, day := range days {
+			fmt.Printf("  %-12s $%.2f\n", day, output.ByDay[day])
+		}
+	}
+
 	// Session count
 	fmt.Printf("\n%s %d sessions\n", style.Dim.Render("Entries:"), len(entries))
 
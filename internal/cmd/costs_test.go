@@ -3,6 +3,7 @@ package cmd
 import (
 	"os"
 	"testing"
+	"time"
 )
 
 func TestDeriveSessionName(t *testing.T) {
@@ -112,3 +113,29 @@ func TestDeriveSessionName(t *testing.T) {
 		})
 	}
 }
+
+func TestSumCostsBy(t *testing.T) {
+	day1 := time.Date(2026, 1, 5, 22, 0, 0, 0, time.UTC)
+	day2 := time.Date(2026, 1, 6, 9, 0, 0, 0, time.UTC)
+	entries := []CostEntry{
+		{SessionID: "gt-gastown-toast", Role: "polecat", Rig: "gastown", CostUSD: 1.50, EndedAt: day1},
+		{SessionID: "gt-gastown-toast", Role: "polecat", Rig: "gastown", CostUSD: 0.50, EndedAt: day2},
+		{SessionID: "gt-beads-witness", Role: "witness", Rig: "beads", CostUSD: 2.00, EndedAt: day2},
+		{SessionID: "hq-mayor", Role: "mayor", CostUSD: 3.00, StartedAt: day1},
+	}
+
+	bySession := sumCostsBy(entries, func(e CostEntry) string { return e.SessionID })
+	if bySession["gt-gastown-toast"] != 2.00 || bySession["gt-beads-witness"] != 2.00 || bySession["hq-mayor"] != 3.00 {
+		t.Errorf("by session = %v", bySession)
+	}
+
+	byRig := sumCostsBy(entries, func(e CostEntry) string { return e.Rig })
+	if len(byRig) != 2 || byRig["gastown"] != 2.00 || byRig["beads"] != 2.00 {
+		t.Errorf("by rig = %v, want town-level mayor excluded", byRig)
+	}
+
+	byDay := sumCostsBy(entries, costEntryDay)
+	if byDay["2026-01-05"] != 4.50 || byDay["2026-01-06"] != 2.50 {
+		t.Errorf("by day = %v", byDay)
+	}
+}
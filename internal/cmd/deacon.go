@@ -17,6 +17,7 @@ import (
 	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/constants"
 	"github.com/steveyegge/gastown/internal/deacon"
+	"github.com/steveyegge/gastown/internal/dog"
 	"github.com/steveyegge/gastown/internal/polecat"
 	"github.com/steveyegge/gastown/internal/runtime"
 	"github.com/steveyegge/gastown/internal/session"
@@ -568,9 +569,31 @@ func runDeaconStatus(cmd *cobra.Command, args []string) error {
 		fmt.Printf("\nStart with: %s\n", style.Dim.Render("gt deacon start"))
 	}
 
+	if townRoot != "" {
+		printKennelStatus()
+	}
+
 	return nil
 }
 
+// printKennelStatus renders the deacon's dog pool: each dog's state and
+// current assignment. Kennel errors are non-fatal for status.
+func printKennelStatus() {
+	pool, err := DogPoolStatus()
+	if err != nil || len(pool) == 0 {
+		return
+	}
+
+	fmt.Printf("\n%s\n", style.Bold.Render("Kennel:"))
+	for _, d := range pool {
+		if d.State == dog.StateWorking {
+			fmt.Printf("  %s %s  %s  %s\n", style.Bold.Render("●"), d.Name, style.Bold.Render("working"), d.Work)
+		} else {
+			fmt.Printf("  %s %s  %s\n", style.Dim.Render("○"), d.Name, style.Dim.Render("idle"))
+		}
+	}
+}
+
 func runDeaconRestart(cmd *cobra.Command, args []string) error {
 	t := tmux.NewTmux()
 
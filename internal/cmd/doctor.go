@@ -41,6 +41,7 @@ Infrastructure checks:
   - stale-binary             Check if gt binary is up to date with repo
   - daemon                   Check if daemon is running (fixable)
   - repo-fingerprint         Check database has valid repo fingerprint (fixable)
+  - beads-migrations         Warn when beads databases have pending migrations
   - boot-health              Check Boot watchdog health (vet mode)
 
 Cleanup checks (fixable):
@@ -124,6 +125,7 @@ func runDoctor(cmd *cobra.Command, args []string) error {
 	d.Register(doctor.NewPreCheckoutHookCheck())
 	d.Register(doctor.NewDaemonCheck())
 	d.Register(doctor.NewRepoFingerprintCheck())
+	d.Register(doctor.NewMigrationCheck())
 	d.Register(doctor.NewBootHealthCheck())
 	d.Register(doctor.NewBeadsDatabaseCheck())
 	d.Register(doctor.NewCustomTypesCheck())
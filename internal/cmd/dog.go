@@ -132,6 +132,22 @@ Examples:
 	RunE: runDogCall,
 }
 
+var dogCancelCmd = &cobra.Command{
+	Use:   "cancel <name>",
+	Short: "Cancel the work dispatched to a dog",
+	Long: `Cancel a dispatch that was sent to a dog by mistake.
+
+The dog's bead is released back to open with no assignee, and the dog
+returns to idle. Plugin and formula dispatches have no bead to release;
+the dog is just idled. If the dog has a running session, it is interrupted
+and told the dispatch was cancelled. Use 'gt dog list' to see what each dog is working on.
+
+Examples:
+  gt dog cancel alpha`,
+	Args: cobra.ExactArgs(1),
+	RunE: runDogCancel,
+}
+
 var dogStatusCmd = &cobra.Command{
 	Use:   "status [name]",
 	Short: "Show detailed dog status",
@@ -210,6 +226,7 @@ func init() {
 	dogCmd.AddCommand(dogRemoveCmd)
 	dogCmd.AddCommand(dogListCmd)
 	dogCmd.AddCommand(dogCallCmd)
+	dogCmd.AddCommand(dogCancelCmd)
 	dogCmd.AddCommand(dogStatusCmd)
 	dogCmd.AddCommand(dogDispatchCmd)
 
@@ -411,6 +428,34 @@ func runDogList(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+func runDogCancel(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwd()
+	if err != nil {
+		return fmt.Errorf("finding town root: %w", err)
+	}
+	mgr, err := getDogManager()
+	if err != nil {
+		return err
+	}
+
+	work, err := cancelDogDispatch(townRoot, mgr, args[0])
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%s Cancelled %s on dog %s\n", style.Bold.Render("✓"), work, args[0])
+	if looksLikeBeadID(work) {
+		fmt.Printf("  Released %s → open\n", work)
+	}
+
+	interrupted, err := interruptDog(tmux.NewTmux(), townRoot, args[0], work)
+	if err != nil {
+		style.PrintWarning("could not interrupt dog %s: %v", args[0], err)
+	} else if interrupted {
+		fmt.Printf("  Interrupted session %s\n", dogSessionName(townRoot, args[0]))
+	}
+	return nil
+}
+
 func runDogCall(cmd *cobra.Command, args []string) error {
 	mgr, err := getDogManager()
 	if err != nil {
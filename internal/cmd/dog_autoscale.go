@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/dog"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+// Autoscale flags
+var (
+	dogAutoscaleMin          int
+	dogAutoscaleMax          int
+	dogAutoscaleScaleUpDepth int
+	dogAutoscaleIdleFor      time.Duration
+	dogAutoscaleQueueDepth   int
+)
+
+var dogAutoscaleCmd = &cobra.Command{
+	Use:   "autoscale --queue-depth <n>",
+	Short: "Grow or shrink the kennel to match queued work",
+	Long: `Run one autoscaling step against the kennel.
+
+The caller passes the number of dispatches waiting for a dog as
+--queue-depth:
+  - The kennel is first grown to --min dogs.
+  - While the queue is deeper than --scale-up-depth, one dog is added per
+    step, up to --max.
+  - When the queue is empty, dogs idle for at least --idle-for are removed,
+    longest idle first, down to --min. Working dogs are never removed.
+
+Examples:
+  gt dog autoscale --queue-depth 5
+  gt dog autoscale --queue-depth 0 --min 2 --max 6 --idle-for 1h`,
+	Args: cobra.NoArgs,
+	RunE: runDogAutoscale,
+}
+
+func init() {
+	dogAutoscaleCmd.Flags().IntVar(&dogAutoscaleMin, "min", 1, "Dogs to keep even when idle")
+	dogAutoscaleCmd.Flags().IntVar(&dogAutoscaleMax, "max", 4, "Maximum kennel size")
+	dogAutoscaleCmd.Flags().IntVar(&dogAutoscaleScaleUpDepth, "scale-up-depth", 0, "Queue depth above which a dog is added")
+	dogAutoscaleCmd.Flags().DurationVar(&dogAutoscaleIdleFor, "idle-for", 30*time.Minute, "How long a dog must be idle before removal")
+	dogAutoscaleCmd.Flags().IntVar(&dogAutoscaleQueueDepth, "queue-depth", 0, "Dispatches waiting for a dog (required)")
+	_ = dogAutoscaleCmd.MarkFlagRequired("queue-depth")
+
+	dogCmd.AddCommand(dogAutoscaleCmd)
+}
+
+func runDogAutoscale(cmd *cobra.Command, args []string) error {
+	mgr, err := getDogManager()
+	if err != nil {
+		return err
+	}
+
+	policy := dog.AutoscalePolicy{
+		Min:          dogAutoscaleMin,
+		Max:          dogAutoscaleMax,
+		ScaleUpDepth: dogAutoscaleScaleUpDepth,
+		IdleFor:      dogAutoscaleIdleFor,
+	}
+	result, err := mgr.Autoscale(policy, func() (int, error) { return dogAutoscaleQueueDepth, nil })
+	if result != nil {
+		if len(result.Added) > 0 {
+			fmt.Printf("%s Added %s (queue depth %d)\n", style.Bold.Render("✓"), strings.Join(result.Added, ", "), result.Depth)
+		}
+		if len(result.Removed) > 0 {
+			fmt.Printf("%s Removed idle %s\n", style.Bold.Render("✓"), strings.Join(result.Removed, ", "))
+		}
+		if err == nil && len(result.Added) == 0 && len(result.Removed) == 0 {
+			fmt.Printf("%s Kennel unchanged (queue depth %d)\n", style.Dim.Render("○"), result.Depth)
+		}
+	}
+	return err
+}
@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/beads"
@@ -31,6 +33,10 @@ This is a convenience command for polecats that:
 3. Notifies the Witness with the exit outcome
 4. Exits the Claude session (polecats don't stay alive after completion)
 
+If work was queued behind the completed bead (gt sling --on-existing=queue),
+it is hooked on a fresh branch instead, the Witness is not notified, and the
+session stays up to run it.
+
 Exit statuses:
   COMPLETED      - Work done, MR submitted (default)
   ESCALATED      - Hit blocker, needs human intervention
@@ -401,6 +407,15 @@ func runDone(cmd *cobra.Command, args []string) error {
 		fmt.Printf("  Branch: %s\n", branch)
 	}
 
+	// Work queued behind this bead (gt sling --on-existing=queue) keeps a
+	// polecat alive. Pick it up before signalling: POLECAT_DONE would have
+	// the Witness nuke this polecat once the MR merges, mid-way through the
+	// queued work.
+	var nextWork string
+	if exitType == ExitCompleted && cwdAvailable {
+		nextWork = pickUpQueuedWork(cwd, townRoot, g, defaultBranch)
+	}
+
 	// Notify Witness about completion
 	// Use town-level beads for cross-agent mail
 	townRouter := mail.NewRouter(townRoot)
@@ -427,11 +442,15 @@ func runDone(cmd *cobra.Command, args []string) error {
 		Body:    strings.Join(bodyLines, "\n"),
 	}
 
-	fmt.Printf("\nNotifying Witness...\n")
-	if err := townRouter.Send(doneNotification); err != nil {
-		style.PrintWarning("could not notify witness: %v", err)
+	if nextWork != "" {
+		fmt.Printf("\n%s Not notifying Witness: staying up for queued work %s\n", style.Dim.Render("○"), nextWork)
 	} else {
-		fmt.Printf("%s Witness notified of %s\n", style.Bold.Render("✓"), exitType)
+		fmt.Printf("\nNotifying Witness...\n")
+		if err := townRouter.Send(doneNotification); err != nil {
+			style.PrintWarning("could not notify witness: %v", err)
+		} else {
+			fmt.Printf("%s Witness notified of %s\n", style.Bold.Render("✓"), exitType)
+		}
 	}
 
 	// Notify dispatcher if work was dispatched by another agent
@@ -456,7 +475,15 @@ func runDone(cmd *cobra.Command, args []string) error {
 	_ = events.LogFeed(events.TypeDone, sender, events.DonePayload(issueID, branch))
 
 	// Update agent bead state (ZFC: self-report completion)
-	updateAgentStateOnDone(cwd, townRoot, exitType, issueID)
+	updateAgentStateOnDone(cwd, townRoot, exitType, issueID, nextWork)
+
+	// Queued work is now on the hook, so the session stays up to run it.
+	if nextWork != "" {
+		fmt.Println()
+		fmt.Printf("%s Queued work %s is now on your hook\n", style.Bold.Render("→"), nextWork)
+		fmt.Printf("  Run `gt prime` to start it.\n")
+		return nil
+	}
 
 	// Self-cleaning: Nuke our own sandbox and session (if we're a polecat)
 	// This is the self-cleaning model - polecats clean up after themselves
@@ -497,7 +524,45 @@ func runDone(cmd *cobra.Command, args []string) error {
 	return nil // unreachable, but keeps compiler happy
 }
 
+// pickUpQueuedWork returns the next bead queued for this polecat, if any,
+// after moving the worktree onto a fresh branch for it cut from
+// origin/<defaultBranch> (named as polecat.Manager names spawn branches).
+// The finished branch stays behind for the merge queue. Returns "" for
+// non-polecats, when nothing is queued, or if the branch can't be set up.
+func pickUpQueuedWork(cwd, townRoot string, g *git.Git, defaultBranch string) string {
+	roleInfo, err := GetRoleWithContext(cwd, townRoot)
+	if err != nil || roleInfo.Role != RolePolecat || roleInfo.Polecat == "" {
+		return ""
+	}
+	agent := fmt.Sprintf("%s/polecats/%s", roleInfo.Rig, roleInfo.Polecat)
+	next, err := nextQueued(beads.New(filepath.Join(townRoot, roleInfo.Rig)), agent)
+	if err != nil {
+		style.PrintWarning("could not check for queued work: %v", err)
+		return ""
+	}
+	if next == "" {
+		return ""
+	}
+
+	if err := g.Fetch("origin"); err != nil {
+		style.PrintWarning("could not fetch origin: %v", err)
+	}
+	branch := fmt.Sprintf("polecat/%s/%s@%s", roleInfo.Polecat, next, strconv.FormatInt(time.Now().UnixMilli(), 36))
+	if err := g.CreateBranchFrom(branch, "origin/"+defaultBranch); err != nil {
+		style.PrintWarning("could not create branch for queued work %s: %v", next, err)
+		return ""
+	}
+	if err := g.Checkout(branch); err != nil {
+		style.PrintWarning("could not switch to branch for queued work %s: %v", next, err)
+		return ""
+	}
+	fmt.Printf("%s Switched to %s for queued work %s\n", style.Bold.Render("✓"), branch, next)
+	return next
+}
+
 // updateAgentStateOnDone clears the agent's hook and reports cleanup status.
+// If nextWork is set (see pickUpQueuedWork), it is hooked in place of the
+// completed bead.
 // Per gt-zecmc: observable states ("done", "idle") removed - use tmux to discover.
 // Non-observable states ("stuck", "awaiting-gate") are still set since they represent
 // intentional agent decisions that can't be observed from tmux.
@@ -507,7 +572,7 @@ func runDone(cmd *cobra.Command, args []string) error {
 // BUG FIX (hq-3xaxy): This function must be resilient to working directory deletion.
 // If the polecat's worktree is deleted before gt done finishes, we use env vars as fallback.
 // All errors are warnings, not failures - gt done must complete even if bead ops fail.
-func updateAgentStateOnDone(cwd, townRoot, exitType, _ string) { // issueID unused but kept for future audit logging
+func updateAgentStateOnDone(cwd, townRoot, exitType, _, nextWork string) { // issueID unused but kept for future audit logging
 	// Get role context - try multiple sources for resilience
 	roleInfo, err := GetRoleWithContext(cwd, townRoot)
 	if err != nil {
@@ -582,6 +647,14 @@ func updateAgentStateOnDone(cwd, townRoot, exitType, _ string) { // issueID unus
 		hookedBeadID := agentBead.HookBead
 		// Only close if the hooked bead exists and is still in "hooked" status
 		if hookedBead, err := bd.Show(hookedBeadID); err == nil && hookedBead.Status == beads.StatusHooked {
+			// Close the guiding wisp too once all its steps are done, so it
+			// doesn't outlive the work it was attached to.
+			if fields := beads.ParseAttachmentFields(hookedBead); fields != nil &&
+				fields.AttachedMolecule != "" && fields.AttachedMolecule != hookedBeadID {
+				if _, err := bd.MolFinalize(fields.AttachedMolecule); err != nil {
+					fmt.Fprintf(os.Stderr, "Warning: couldn't finalize molecule %s: %v\n", fields.AttachedMolecule, err)
+				}
+			}
 			if err := bd.Close(hookedBeadID); err != nil {
 				// Non-fatal: warn but continue
 				fmt.Fprintf(os.Stderr, "Warning: couldn't close hooked bead %s: %v\n", hookedBeadID, err)
@@ -597,6 +670,16 @@ func updateAgentStateOnDone(cwd, townRoot, exitType, _ string) { // issueID unus
 		fmt.Fprintf(os.Stderr, "Warning: couldn't clear agent %s hook: %v\n", agentBeadID, err)
 	}
 
+	// Hook the queued work picked up by pickUpQueuedWork
+	if nextWork != "" {
+		status := beads.StatusHooked
+		if err := bd.Update(nextWork, beads.UpdateOptions{Status: &status}); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: couldn't hook queued work %s: %v\n", nextWork, err)
+		} else if err := bd.SetHookBead(agentBeadID, nextWork); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: couldn't set agent %s hook to %s: %v\n", agentBeadID, nextWork, err)
+		}
+	}
+
 	// Only set non-observable states - "stuck" and "awaiting-gate" are intentional
 	// agent decisions that can't be discovered from tmux. Skip "done" and "idle"
 	// since those are observable (no session = done, session + no hook = idle).
@@ -621,7 +704,6 @@ func updateAgentStateOnDone(cwd, townRoot, exitType, _ string) { // issueID unus
 		if cleanupStatus != polecat.CleanupUnknown {
 			if err := bd.UpdateAgentCleanupStatus(agentBeadID, string(cleanupStatus)); err != nil {
 				fmt.Fprintf(os.Stderr, "Warning: couldn't update agent %s cleanup status: %v\n", agentBeadID, err)
-				return
 			}
 		}
 	}
@@ -4,15 +4,20 @@ import (
 	"bufio"
 	"crypto/rand"
 	"encoding/base32"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io/fs"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/formula"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/workspace"
 	"golang.org/x/text/cases"
@@ -64,10 +69,12 @@ var formulaListCmd = &cobra.Command{
 	Short: "List available formulas",
 	Long: `List available formulas from all search paths.
 
-Searches for formula files (.formula.toml, .formula.json) in:
+Searches for formula files (.formula.toml) in:
   1. .beads/formulas/ (project)
-  2. ~/.beads/formulas/ (user)
-  3. $GT_ROOT/.beads/formulas/ (orchestrator)
+  2. $GT_ROOT/.beads/formulas/ (orchestrator)
+  3. ~/.beads/formulas/ (user)
+
+Each formula is shown with its type, step count and number of variables.
 
 Examples:
   gt formula list            # List all formulas
@@ -168,17 +175,59 @@ func init() {
 	rootCmd.AddCommand(formulaCmd)
 }
 
-// runFormulaList delegates to bd formula list
+// runFormulaList lists the formulas in the search paths, with their step
+// counts and variables. A formula found in more than one path is listed once,
+// from the path findFormulaFile would use.
 func runFormulaList(cmd *cobra.Command, args []string) error {
-	bdArgs := []string{"formula", "list"}
+	var entries []formula.ListEntry
+	seen := make(map[string]bool)
+	for _, dir := range formulaSearchPaths() {
+		dirEntries, err := formula.ListDir(dir)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("listing formulas in %s: %w", dir, err)
+		}
+		for _, entry := range dirEntries {
+			if !seen[entry.Name] {
+				seen[entry.Name] = true
+				entries = append(entries, entry)
+			}
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Name < entries[j].Name })
+
 	if formulaListJSON {
-		bdArgs = append(bdArgs, "--json")
+		if entries == nil {
+			entries = []formula.ListEntry{}
+		}
+		out, err := json.MarshalIndent(entries, "", "  ")
+		if err != nil {
+			return fmt.Errorf("encoding formulas: %w", err)
+		}
+		fmt.Println(string(out))
+		return nil
 	}
 
-	bdCmd := exec.Command("bd", bdArgs...)
-	bdCmd.Stdout = os.Stdout
-	bdCmd.Stderr = os.Stderr
-	return bdCmd.Run()
+	if len(entries) == 0 {
+		fmt.Println("No formulas found")
+		return nil
+	}
+	for _, entry := range entries {
+		detail := fmt.Sprintf("%d steps", entry.StepCount)
+		if entry.Type != "" {
+			detail = string(entry.Type) + ", " + detail
+		}
+		if len(entry.Variables) > 0 {
+			detail += fmt.Sprintf(", %d vars", len(entry.Variables))
+		}
+		fmt.Printf("  %s %s\n", style.Bold.Render(entry.Name), style.Dim.Render("("+detail+")"))
+		if entry.Description != "" {
+			fmt.Printf("    %s\n", strings.SplitN(strings.TrimSpace(entry.Description), "\n", 2)[0])
+		}
+	}
+	return nil
 }
 
 // runFormulaShow delegates to bd formula show
@@ -515,9 +564,8 @@ type formulaSynthesis struct {
 	DependsOn   []string
 }
 
-// findFormulaFile searches for a formula file by name
-func findFormulaFile(name string) (string, error) {
-	// Search paths in order
+// formulaSearchPaths returns the formula directories in lookup order.
+func formulaSearchPaths() []string {
 	searchPaths := []string{}
 
 	// 1. Project .beads/formulas/
@@ -535,9 +583,14 @@ func findFormulaFile(name string) (string, error) {
 		searchPaths = append(searchPaths, filepath.Join(home, ".beads", "formulas"))
 	}
 
+	return searchPaths
+}
+
+// findFormulaFile searches for a formula file by name
+func findFormulaFile(name string) (string, error) {
 	// Try each path with common extensions
 	extensions := []string{".formula.toml", ".formula.json"}
-	for _, basePath := range searchPaths {
+	for _, basePath := range formulaSearchPaths() {
 		for _, ext := range extensions {
 			path := filepath.Join(basePath, name+ext)
 			if _, err := os.Stat(path); err == nil {
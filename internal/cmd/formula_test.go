@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/formula"
+)
+
+func TestRunFormulaList(t *testing.T) {
+	project := t.TempDir()
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("GT_TOWN_ROOT", "")
+
+	writeFormula := func(dir, name, content string) {
+		t.Helper()
+		formulas := filepath.Join(dir, ".beads", "formulas")
+		if err := os.MkdirAll(formulas, 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(formulas, name+".formula.toml"), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeFormula(project, "ship-it", `
+formula = "ship-it"
+description = "Ship a feature"
+
+[[steps]]
+id = "build"
+title = "Build"
+
+[[steps]]
+id = "release"
+title = "Release"
+needs = ["build"]
+
+[vars.version]
+required = true
+`)
+	// Shadowed by the project formula of the same name
+	writeFormula(home, "ship-it", `
+formula = "ship-it"
+
+[[steps]]
+id = "only"
+title = "Only"
+`)
+	writeFormula(home, "tidy", `
+formula = "tidy"
+
+[[steps]]
+id = "sweep"
+title = "Sweep"
+`)
+
+	originalWd, _ := os.Getwd()
+	defer os.Chdir(originalWd)
+	if err := os.Chdir(project); err != nil {
+		t.Fatal(err)
+	}
+
+	origJSON := formulaListJSON
+	defer func() { formulaListJSON = origJSON }()
+	formulaListJSON = true
+
+	var runErr error
+	out := captureStdout(t, func() { runErr = runFormulaList(nil, nil) })
+	if runErr != nil {
+		t.Fatalf("runFormulaList: %v", runErr)
+	}
+
+	var entries []formula.ListEntry
+	if err := json.Unmarshal([]byte(out), &entries); err != nil {
+		t.Fatalf("parsing output %q: %v", out, err)
+	}
+	if len(entries) != 2 || entries[0].Name != "ship-it" || entries[1].Name != "tidy" {
+		t.Fatalf("entries = %+v, want ship-it and tidy", entries)
+	}
+	if entries[0].StepCount != 2 || len(entries[0].Variables) != 1 || entries[0].Variables[0].Name != "version" {
+		t.Errorf("ship-it = %+v, want the project formula's 2 steps and version var", entries[0])
+	}
+}
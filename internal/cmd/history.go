@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var historyJSON bool
+
+var historyCmd = &cobra.Command{
+	Use:     "history <bead-id>",
+	GroupID: GroupDiag,
+	Short:   "Show the event history of a bead",
+	Long: `Show what happened to a bead, oldest first.
+
+Events include creation, status and assignee changes made through gt,
+comments, molecule attachment, detach/burn/squash operations from the audit
+log, and close. Use this to answer "why is this bead in this state?".
+
+Examples:
+  gt history gt-abc
+  gt history gt-abc --json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runHistory,
+}
+
+func init() {
+	historyCmd.Flags().BoolVar(&historyJSON, "json", false, "Output as JSON")
+	rootCmd.AddCommand(historyCmd)
+}
+
+func runHistory(cmd *cobra.Command, args []string) error {
+	beadID := args[0]
+
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	cwd, _ := os.Getwd()
+
+	events, err := beadsForBead(townRoot, beadID, cwd).IssueHistory(beadID)
+	if err != nil {
+		return fmt.Errorf("getting history for %s: %w", beadID, err)
+	}
+
+	if historyJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(events)
+	}
+
+	fmt.Printf("%s %s\n\n", style.Bold.Render("History:"), beadID)
+	for _, e := range events {
+		actor := ""
+		if e.Actor != "" {
+			actor = style.Dim.Render(" by " + e.Actor)
+		}
+		fmt.Printf("  %-20s %-9s%s  %s\n", style.Dim.Render(e.Time), e.Kind, actor, e.Detail)
+	}
+	return nil
+}
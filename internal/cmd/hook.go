@@ -12,6 +12,7 @@ import (
 	"github.com/steveyegge/gastown/internal/events"
 	"github.com/steveyegge/gastown/internal/runtime"
 	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
 )
 
 var hookCmd = &cobra.Command{
@@ -135,8 +136,9 @@ func runHook(_ *cobra.Command, args []string) error {
 		return fmt.Errorf("polecats cannot hook work (use gt done for handoff)")
 	}
 
-	// Verify the bead exists
-	if err := verifyBeadExists(beadID); err != nil {
+	// Verify the bead exists (from the town root, if in one, so prefix routes apply)
+	townRoot, _ := workspace.FindFromCwd()
+	if err := verifyBeadExists(townRoot, beadID); err != nil {
 		return err
 	}
 
@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/constants"
 	"github.com/steveyegge/gastown/internal/git"
 	"github.com/steveyegge/gastown/internal/rig"
@@ -149,9 +150,12 @@ func registerCustomTypes(workDir string) error {
 		return nil // beads not installed, skip silently
 	}
 
-	// Check if .beads directory exists
-	beadsDir := filepath.Join(workDir, ".beads")
-	if _, err := os.Stat(beadsDir); os.IsNotExist(err) {
+	// Check for a beads DB, here or behind a worktree redirect
+	kind, err := beads.BeadsRepoKind(workDir)
+	if err != nil {
+		return err
+	}
+	if kind == beads.RepoKindNone {
 		return nil // no beads DB yet, skip silently
 	}
 
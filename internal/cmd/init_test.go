@@ -0,0 +1,41 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRegisterCustomTypesReportsRepoErrors(t *testing.T) {
+	binDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(binDir, "bd"), []byte("#!/bin/sh\nexit 0\n"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	// No beads directory: nothing to register
+	if err := registerCustomTypes(t.TempDir()); err != nil {
+		t.Errorf("no repo: registerCustomTypes = %v, want nil", err)
+	}
+
+	// A workDir whose .beads cannot be stat'ed is an error, not "no repo"
+	file := filepath.Join(t.TempDir(), "file")
+	if err := os.WriteFile(file, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := registerCustomTypes(file); err == nil {
+		t.Error("unreadable repo: registerCustomTypes = nil, want error")
+	}
+
+	// A dangling redirect is an error
+	worktree := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(worktree, ".beads"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(worktree, ".beads", "redirect"), []byte("../missing/.beads\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := registerCustomTypes(worktree); err == nil {
+		t.Error("dangling redirect: registerCustomTypes = nil, want error")
+	}
+}
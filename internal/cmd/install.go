@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"os/exec"
@@ -355,35 +356,27 @@ func writeJSON(path string, data interface{}) error {
 // initTownBeads initializes town-level beads database using bd init.
 // Town beads use the "hq-" prefix for mayor mail and cross-rig coordination.
 func initTownBeads(townPath string) error {
-	// Run: bd init --prefix hq
-	cmd := exec.Command("bd", "init", "--prefix", "hq")
-	cmd.Dir = townPath
-
-	output, err := cmd.CombinedOutput()
-	if err != nil {
-		// Check if beads is already initialized
-		if strings.Contains(string(output), "already initialized") {
-			// Already initialized - still need to ensure fingerprint exists
-		} else {
-			return fmt.Errorf("bd init failed: %s", strings.TrimSpace(string(output)))
-		}
-	}
-
-	// Configure custom types for Gas Town (agent, role, rig, convoy, slot).
-	// These were extracted from beads core in v0.46.0 and now require explicit config.
-	configCmd := exec.Command("bd", "config", "set", "types.custom", constants.BeadsCustomTypes)
-	configCmd.Dir = townPath
-	if configOutput, configErr := configCmd.CombinedOutput(); configErr != nil {
-		// Non-fatal: older beads versions don't need this, newer ones do
-		fmt.Printf("   %s Could not set custom types: %s\n", style.Dim.Render("⚠"), strings.TrimSpace(string(configOutput)))
-	}
-
-	// Configure allowed_prefixes for convoy beads (hq-cv-* IDs).
-	// This allows bd create --id=hq-cv-xxx to pass prefix validation.
-	prefixCmd := exec.Command("bd", "config", "set", "allowed_prefixes", "hq,hq-cv")
-	prefixCmd.Dir = townPath
-	if prefixOutput, prefixErr := prefixCmd.CombinedOutput(); prefixErr != nil {
-		fmt.Printf("   %s Could not set allowed_prefixes: %s\n", style.Dim.Render("⚠"), strings.TrimSpace(string(prefixOutput)))
+	// Run: bd init --prefix hq, configuring in the same step:
+	//   - custom types for Gas Town (agent, role, rig, convoy, slot), which
+	//     were extracted from beads core in v0.46.0 and now require explicit config
+	//   - allowed_prefixes for convoy beads (hq-cv-* IDs), so that
+	//     bd create --id=hq-cv-xxx passes prefix validation
+	b := beads.New(townPath)
+	config := map[string]string{
+		"types.custom":     constants.BeadsCustomTypes,
+		"allowed_prefixes": "hq,hq-cv",
+	}
+	err := b.InitWithOptions(beads.InitOptions{Prefix: "hq", Config: config})
+	if err != nil && strings.Contains(err.Error(), "already initialized") {
+		// Already initialized - still need to ensure config and fingerprint exist
+		err = b.ApplyConfig(config)
+	}
+	var cfgErr *beads.ConfigError
+	if errors.As(err, &cfgErr) {
+		// Non-fatal: older beads versions don't need these, newer ones do
+		fmt.Printf("   %s Could not set %s: %v\n", style.Dim.Render("⚠"), strings.Join(cfgErr.Keys, ", "), cfgErr.Err)
+	} else if err != nil {
+		return fmt.Errorf("bd init failed: %w", err)
 	}
 
 	// Ensure database has repository fingerprint (GH #25).
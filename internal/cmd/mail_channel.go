@@ -271,10 +271,7 @@ func runChannelCreate(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("not in a Gas Town workspace: %w", err)
 	}
 
-	createdBy := os.Getenv("BD_ACTOR")
-	if createdBy == "" {
-		createdBy = "unknown"
-	}
+	createdBy := ResolveActor("")
 
 	b := beads.New(townRoot)
 
@@ -214,10 +214,7 @@ func runGroupCreate(cmd *cobra.Command, args []string) error {
 	}
 
 	// Detect creator
-	createdBy := os.Getenv("BD_ACTOR")
-	if createdBy == "" {
-		createdBy = "unknown"
-	}
+	createdBy := ResolveActor("")
 
 	b := beads.New(townRoot)
 
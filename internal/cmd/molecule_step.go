@@ -114,10 +114,18 @@ func runMoleculeStepDone(cmd *cobra.Command, args []string) error {
 		}
 		result.StepClosed = true
 		fmt.Printf("%s Closed step %s: %s\n", style.Bold.Render("✓"), stepID, step.Title)
+
+		skipped, err := b.SkipUnmetSteps(moleculeID)
+		if err != nil {
+			return fmt.Errorf("skipping steps: %w", err)
+		}
+		for _, id := range skipped {
+			fmt.Printf("%s Skipped step %s (condition not met)\n", style.Dim.Render("○"), id)
+		}
 	}
 
 	// Step 4: Find the next ready step
-	nextStep, allComplete, err := findNextReadyStep(b, moleculeID)
+	nextStep, allComplete, err := b.NextReadyStep(moleculeID)
 	if err != nil {
 		return fmt.Errorf("finding next step: %w", err)
 	}
@@ -186,69 +194,6 @@ func extractMoleculeIDFromStep(stepID string) string {
 	return stepID[:lastDot]
 }
 
-// findNextReadyStep finds the next ready step in a molecule.
-// Returns (nextStep, allComplete, error).
-// If all steps are complete, returns (nil, true, nil).
-// If no steps are ready but some are blocked/in_progress, returns (nil, false, nil).
-func findNextReadyStep(b *beads.Beads, moleculeID string) (*beads.Issue, bool, error) {
-	// Get all children of the molecule
-	children, err := b.List(beads.ListOptions{
-		Parent:   moleculeID,
-		Status:   "all",
-		Priority: -1,
-	})
-	if err != nil {
-		return nil, false, fmt.Errorf("listing molecule steps: %w", err)
-	}
-
-	if len(children) == 0 {
-		return nil, true, nil // No steps = complete
-	}
-
-	// Build set of closed step IDs and collect open steps
-	// Note: "open" means not started. "in_progress" means someone's working on it.
-	// We only consider "open" steps as candidates for the next step.
-	closedIDs := make(map[string]bool)
-	var openSteps []*beads.Issue
-	hasNonClosedSteps := false
-
-	for _, child := range children {
-		switch child.Status {
-		case "closed":
-			closedIDs[child.ID] = true
-		case "open":
-			openSteps = append(openSteps, child)
-			hasNonClosedSteps = true
-		default:
-			// in_progress or other status - not closed, not available
-			hasNonClosedSteps = true
-		}
-	}
-
-	// Check if all complete
-	if !hasNonClosedSteps {
-		return nil, true, nil
-	}
-
-	// Find ready steps (open steps with all dependencies closed)
-	for _, step := range openSteps {
-		allDepsClosed := true
-		for _, depID := range step.DependsOn {
-			if !closedIDs[depID] {
-				allDepsClosed = false
-				break
-			}
-		}
-
-		if len(step.DependsOn) == 0 || allDepsClosed {
-			return step, false, nil
-		}
-	}
-
-	// No ready steps (all blocked or in_progress)
-	return nil, false, nil
-}
-
 // handleStepContinue handles continuing to the next step.
 func handleStepContinue(cwd, townRoot, _ string, nextStep *beads.Issue, dryRun bool) error { // workDir unused but kept for signature consistency
 	fmt.Printf("\n%s Next step: %s\n", style.Bold.Render("→"), nextStep.ID)
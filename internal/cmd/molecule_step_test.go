@@ -234,7 +234,7 @@ func TestFindNextReadyStep(t *testing.T) {
 
 			// Create a real Beads instance but we'll use our mock
 			// For now, we test the logic by calling the actual function with mock data
-			// This requires refactoring findNextReadyStep to accept an interface
+			// This requires refactoring beads.NextReadyStep to accept an interface
 			// For now, we'll test the logic inline
 
 			// Get children from mock
@@ -2,6 +2,7 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
 	"path/filepath"
 	"strings"
@@ -33,7 +34,7 @@ func (s *SpawnedPolecatInfo) AgentID() string {
 
 // SlingSpawnOptions contains options for spawning a polecat via sling.
 type SlingSpawnOptions struct {
-	Force    bool   // Force spawn even if polecat has uncommitted work
+	Force    bool   // Force spawn even if polecat has uncommitted work or the rig is over budget or capacity
 	Account  string // Claude Code account handle to use
 	Create   bool   // Create polecat if it doesn't exist (currently always true for sling)
 	HookBead string // Bead ID to set as hook_bead at spawn time (atomic assignment)
@@ -61,7 +62,14 @@ func SpawnPolecatForSling(rigName string, opts SlingSpawnOptions) (*SpawnedPolec
 	rigMgr := rig.NewManager(townRoot, rigsConfig, g)
 	r, err := rigMgr.GetRig(rigName)
 	if err != nil {
-		return nil, fmt.Errorf("rig '%s' not found", rigName)
+		return nil, fmt.Errorf("rig '%s' not found: %w", rigName, err)
+	}
+
+	// Refuse new polecats once the rig's daily budget is spent
+	if !opts.Force {
+		if err := checkRigBudget(townRoot, rigName); err != nil {
+			return nil, err
+		}
 	}
 
 	// Get polecat manager (with tmux for session-aware allocation)
@@ -69,6 +77,19 @@ func SpawnPolecatForSling(rigName string, opts SlingSpawnOptions) (*SpawnedPolec
 	t := tmux.NewTmux()
 	polecatMgr := polecat.NewManager(r, polecatGit, t)
 
+	// Refuse new polecats once the rig runs its configured maximum
+	if !opts.Force {
+		if capacity, ok := rigPolecatCapacity(townRoot, rigName); ok {
+			running, err := countRunningPolecats(polecatMgr, polecat.NewSessionManager(t, r))
+			if err != nil {
+				return nil, fmt.Errorf("checking %s capacity: %w", rigName, err)
+			}
+			if err := enforceRigCapacity(rigName, capacity, running); err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	// Allocate a new polecat name
 	polecatName, err := polecatMgr.AllocateName()
 	if err != nil {
@@ -119,7 +140,7 @@ func SpawnPolecatForSling(rigName string, opts SlingSpawnOptions) (*SpawnedPolec
 
 	// Resolve account for runtime config
 	accountsPath := constants.MayorAccountsPath(townRoot)
-	claudeConfigDir, accountHandle, err := config.ResolveAccountConfigDir(accountsPath, opts.Account)
+	claudeConfigDir, accountHandle, err := config.ResolveAccountConfigDir(accountsPath, spawnAccount(accountsPath, opts.Account))
 	if err != nil {
 		return nil, fmt.Errorf("resolving account: %w", err)
 	}
@@ -176,9 +197,26 @@ func SpawnPolecatForSling(rigName string, opts SlingSpawnOptions) (*SpawnedPolec
 	}, nil
 }
 
+// discardSpawnedPolecat stops and removes a polecat spawned for a sling that
+// then failed to hook its bead (e.g. another dispatcher claimed it first),
+// so it isn't left running with nothing on its hook. Overridden in tests.
+var discardSpawnedPolecat = func(info *SpawnedPolecatInfo) error {
+	mgr, r, err := getPolecatManager(info.RigName)
+	if err != nil {
+		return err
+	}
+	if err := polecat.NewSessionManager(tmux.NewTmux(), r).Stop(info.PolecatName, true); err != nil && !errors.Is(err, polecat.ErrSessionNotFound) {
+		return fmt.Errorf("stopping session: %w", err)
+	}
+	if err := mgr.RemoveWithOptions(info.PolecatName, true, true); err != nil {
+		return fmt.Errorf("removing worktree: %w", err)
+	}
+	return nil
+}
+
 // IsRigName checks if a target string is a rig name (not a role or path).
 // Returns the rig name and true if it's a valid rig.
-func IsRigName(target string) (string, bool) {
+func IsRigName(townRoot, target string) (string, bool) {
 	// If it contains a slash, it's a path format (rig/role or rig/crew/name)
 	if strings.Contains(target, "/") {
 		return "", false
@@ -191,11 +229,6 @@ func IsRigName(target string) (string, bool) {
 	}
 
 	// Try to load as a rig
-	townRoot, err := workspace.FindFromCwdOrError()
-	if err != nil {
-		return "", false
-	}
-
 	rigsConfigPath := filepath.Join(townRoot, "mayor", "rigs.json")
 	rigsConfig, err := config.LoadRigsConfig(rigsConfigPath)
 	if err != nil {
@@ -0,0 +1,65 @@
+package cmd
+
+import (
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/session"
+	"github.com/steveyegge/gastown/internal/tmux"
+)
+
+// polecatConfigDirs returns the CLAUDE_CONFIG_DIR of every running polecat
+// session, one entry per session. Overridden in tests.
+var polecatConfigDirs = func() []string {
+	t := tmux.NewTmux()
+	sessions, err := t.ListSessions()
+	if err != nil {
+		return nil
+	}
+	var dirs []string
+	for _, s := range sessions {
+		id, err := session.ParseSessionName(s)
+		if err != nil || id.Role != session.RolePolecat {
+			continue
+		}
+		if dir, err := t.GetEnvironment(s, "CLAUDE_CONFIG_DIR"); err == nil && dir != "" {
+			dirs = append(dirs, dir)
+		}
+	}
+	return dirs
+}
+
+// leastUsedAccount returns the pool account with the fewest running
+// sessions, ties going to the earlier account in the pool.
+func leastUsedAccount(pool []string, usage map[string]int) string {
+	if len(pool) == 0 {
+		return ""
+	}
+	best := pool[0]
+	for _, handle := range pool[1:] {
+		if usage[handle] < usage[best] {
+			best = handle
+		}
+	}
+	return best
+}
+
+// spawnAccount returns the account flag for a polecat spawn: the explicit
+// --account if given, else the pool account with the fewest running polecat
+// sessions. Usage comes from the sessions themselves, so it balances across
+// separate gt sling invocations. GT_ACCOUNT still takes precedence in
+// config.ResolveAccountConfigDir.
+func spawnAccount(accountsPath, account string) string {
+	if account != "" {
+		return account
+	}
+	cfg, err := config.LoadAccountsConfig(accountsPath)
+	if err != nil || len(cfg.Pool) == 0 {
+		return ""
+	}
+	usage := make(map[string]int)
+	for _, dir := range polecatConfigDirs() {
+		if handle := cfg.HandleForConfigDir(dir); handle != "" {
+			usage[handle]++
+		}
+	}
+	return leastUsedAccount(cfg.Pool, usage)
+}
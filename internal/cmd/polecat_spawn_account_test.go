@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+func TestLeastUsedAccount(t *testing.T) {
+	pool := []string{"work", "personal"}
+	if got := leastUsedAccount(pool, nil); got != "work" {
+		t.Errorf("no usage = %q, want work", got)
+	}
+	if got := leastUsedAccount(pool, map[string]int{"work": 2, "personal": 1}); got != "personal" {
+		t.Errorf("work busier = %q, want personal", got)
+	}
+	if got := leastUsedAccount(nil, nil); got != "" {
+		t.Errorf("empty pool = %q, want empty", got)
+	}
+}
+
+func TestSpawnAccount(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	// Simulate running polecat sessions; each spawn starts one more.
+	var running []string
+	orig := polecatConfigDirs
+	defer func() { polecatConfigDirs = orig }()
+	polecatConfigDirs = func() []string { return running }
+
+	path := filepath.Join(t.TempDir(), "accounts.json")
+	cfg := config.NewAccountsConfig()
+	cfg.Accounts["work"] = config.Account{ConfigDir: "~/.claude-accounts/work"}
+	cfg.Accounts["personal"] = config.Account{ConfigDir: "~/.claude-accounts/personal"}
+	cfg.Pool = []string{"work", "personal"}
+	if err := config.SaveAccountsConfig(path, cfg); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := spawnAccount(path, "personal"); got != "personal" {
+		t.Errorf("explicit account = %q, want personal", got)
+	}
+	var got []string
+	for i := 0; i < 4; i++ {
+		handle := spawnAccount(path, "")
+		got = append(got, handle)
+		running = append(running, filepath.Join(home, ".claude-accounts", handle))
+	}
+	if got[0] != "work" || got[1] != "personal" || got[2] != "work" || got[3] != "personal" {
+		t.Errorf("pool picks = %v, want alternating work/personal", got)
+	}
+
+	// Sessions on the personal account finishing shift spawns back to it.
+	running = []string{filepath.Join(home, ".claude-accounts", "work")}
+	if got := spawnAccount(path, ""); got != "personal" {
+		t.Errorf("with work busy = %q, want personal", got)
+	}
+	if got := spawnAccount(filepath.Join(t.TempDir(), "missing.json"), ""); got != "" {
+		t.Errorf("no accounts config = %q, want empty", got)
+	}
+}
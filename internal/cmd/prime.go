@@ -228,7 +228,7 @@ func runPrime(cmd *cobra.Command, args []string) error {
 
 	// Run bd prime to output beads workflow context
 	if !primeDryRun {
-		runBdPrime(cwd)
+		runBdPrime(cwd, ctx)
 	} else {
 		explain(true, "bd prime: skipped in dry-run mode")
 	}
@@ -341,8 +341,10 @@ func detectRole(cwd, townRoot string) RoleInfo {
 }
 
 // runBdPrime runs `bd prime` and outputs the result.
-// This provides beads workflow context to the agent.
-func runBdPrime(workDir string) {
+// This provides beads workflow context to the agent. If bd prime fails, the
+// Gas Town PRIME.md context is output in its place. The agent's hook and
+// mail are covered by checkSlungWork and runMailCheckInject.
+func runBdPrime(workDir string, ctx RoleContext) {
 	cmd := exec.Command("bd", "prime")
 	cmd.Dir = workDir
 
@@ -356,6 +358,13 @@ func runBdPrime(workDir string) {
 		if errMsg := strings.TrimSpace(stderr.String()); errMsg != "" {
 			fmt.Fprintf(os.Stderr, "bd prime: %s\n", errMsg)
 		}
+		content := beads.New(workDir).Prime(beads.PrimeVars{
+			Town: filepath.Base(ctx.TownRoot),
+			Rig:  ctx.Rig,
+			Role: string(ctx.Role),
+		})
+		fmt.Println()
+		fmt.Println(strings.TrimSpace(content))
 		return
 	}
 
@@ -461,6 +470,7 @@ func checkSlungWork(ctx RoleContext) bool {
 	fmt.Printf("%s\n\n", style.Bold.Render("## Hooked Work"))
 	fmt.Printf("  Bead ID: %s\n", style.Bold.Render(hookedBead.ID))
 	fmt.Printf("  Title: %s\n", hookedBead.Title)
+	fmt.Printf("  Status: %s\n", hookedBead.Status)
 	if hookedBead.Description != "" {
 		// Show first few lines of description
 		lines := strings.Split(hookedBead.Description, "\n")
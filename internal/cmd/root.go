@@ -163,8 +163,8 @@ func Execute() int {
 		if code, ok := IsSilentExit(err); ok {
 			return code
 		}
-		// Other errors already printed by cobra
-		return 1
+		// Other errors already printed by cobra; sling user errors exit 2
+		return slingExitCode(err)
 	}
 	return 0
 }
@@ -1,7 +1,6 @@
 package cmd
 
 import (
-	"encoding/json"
 	"fmt"
 	"os"
 	"os/exec"
@@ -41,6 +40,8 @@ Target Resolution:
   gt sling gt-abc crew                  # Crew worker in current rig
   gt sling gp-abc greenplace               # Auto-spawn polecat in rig
   gt sling gt-abc greenplace/Toast         # Specific polecat
+  gt sling gt-abc greenplace/crew          # Least-loaded idle crew member (else new polecat)
+  gt sling gt-abc greenplace --to-rig      # Rig, even if a bead is named greenplace
   gt sling gt-abc mayor                 # Mayor
   gt sling gt-abc deacon/dogs           # Auto-dispatch to idle dog
   gt sling gt-abc deacon/dogs/alpha     # Specific dog
@@ -50,6 +51,11 @@ Spawning Options (when target is a rig):
   gt sling gp-abc greenplace --force                # Ignore unread mail
   gt sling gp-abc greenplace --account work         # Use specific Claude account
 
+Busy Polecats (target polecat already has hooked work):
+  gt sling gt-abc greenplace/polecats/Toast                         # Reject (default)
+  gt sling gt-abc greenplace/polecats/Toast --on-existing=queue     # Assign behind current work
+  gt sling gt-abc greenplace/polecats/Toast --on-existing=replace   # Release current work, hook this
+
 Natural Language Args:
   gt sling gt-abc --args "patch release"
   gt sling code-review --args "focus on security"
@@ -76,7 +82,21 @@ Batch Slinging:
   gt sling gt-abc gt-def gt-ghi gastown   # Sling multiple beads to a rig
 
   When multiple beads are provided with a rig target, each bead gets its own
-  polecat. This parallelizes work dispatch without running gt sling N times.`,
+  polecat. This parallelizes work dispatch without running gt sling N times.
+  --spawn-rate (or the town's sling.spawn_rate) caps spawns per minute.
+
+Epic Expansion:
+  gt sling gt-epic gastown --expand       # Sling the epic's ready children
+
+  With --expand, an epic is not hooked itself: each of its children that is
+  open, unassigned and unblocked is batch-slung to its own polecat.
+
+Scheduled Slinging:
+  gt sling gt-abc gastown --at 8h                    # Dispatch in eight hours
+  gt sling gt-abc gastown --at 2026-01-02T22:00:00Z  # Dispatch at a fixed time
+
+  With --at, the bead is recorded as scheduled instead of slung. gt sling
+  tick dispatches scheduled beads once their start time has passed.`,
 	Args: cobra.MinimumNArgs(1),
 	RunE: runSling,
 }
@@ -95,6 +115,15 @@ var (
 	slingAccount  string // --account: Claude Code account handle to use
 	slingAgent    string // --agent: override runtime agent for this sling/spawn
 	slingNoConvoy bool   // --no-convoy: skip auto-convoy creation
+
+	slingOnExisting string // --on-existing: reject|queue|replace when the target polecat is busy
+	slingFailFast   bool   // --fail-fast: stop a batch sling on the first unrecoverable error
+	slingAttempts   int    // --max-attempts: batch sling spawn attempts per bead
+	slingExpand     bool   // --expand: sling an epic's ready children instead of the epic
+	slingToRig      bool   // --to-rig: the target is a rig even if a bead has the same name
+	slingAsBead     bool   // --bead: ambiguous arguments are beads, not rigs or formulas
+	slingAt         string // --at: schedule the sling for later (RFC3339 time or duration)
+	slingSpawnRate  int    // --spawn-rate: batch sling polecat spawns per minute (0 = unpaced)
 )
 
 func init() {
@@ -110,15 +139,26 @@ func init() {
 	slingCmd.Flags().BoolVar(&slingForce, "force", false, "Force spawn even if polecat has unread mail")
 	slingCmd.Flags().StringVar(&slingAccount, "account", "", "Claude Code account handle to use")
 	slingCmd.Flags().StringVar(&slingAgent, "agent", "", "Override agent/runtime for this sling (e.g., claude, gemini, codex, or custom alias)")
-	slingCmd.Flags().BoolVar(&slingNoConvoy, "no-convoy", false, "Skip auto-convoy creation for single-issue sling")
+	slingCmd.Flags().BoolVar(&slingNoConvoy, "no-convoy", false, "Skip auto-convoy creation for single-issue sling (overrides sling.auto_convoy)")
+	slingCmd.Flags().BoolVar(&slingFailFast, "fail-fast", false, "Batch sling: stop on the first unrecoverable error (rig missing, over budget or capacity)")
+	slingCmd.Flags().IntVar(&slingAttempts, "max-attempts", 1, "Batch sling: spawn attempts per bead; failed spawns are requeued for a later pass")
+	slingCmd.Flags().BoolVar(&slingExpand, "expand", false, "Sling an epic's ready children (each to its own polecat) instead of the epic itself")
+	slingCmd.Flags().BoolVar(&slingToRig, "to-rig", false, "Treat the target as a rig (spawn a polecat) even if a bead has the same name")
+	slingCmd.Flags().BoolVar(&slingAsBead, "bead", false, "Treat ambiguous arguments as beads rather than rigs or formulas")
+	slingCmd.Flags().IntVar(&slingSpawnRate, "spawn-rate", 0, "Batch sling: max polecat spawns per minute (default: town sling.spawn_rate, 0 = unpaced)")
+	slingCmd.Flags().StringVar(&slingAt, "at", "", "Schedule the sling instead of dispatching now: RFC3339 time or duration (dispatched by gt sling tick)")
+	slingCmd.Flags().StringVar(&slingOnExisting, "on-existing", string(OnExistingReject), "When the target polecat already has hooked work: reject, queue, or replace")
 
 	rootCmd.AddCommand(slingCmd)
 }
 
-func runSling(cmd *cobra.Command, args []string) error {
+func runSling(cmd *cobra.Command, args []string) (err error) {
+	// Tag failures as user, system or transient (see SlingError)
+	defer func() { err = classifySlingError(err) }()
+
 	// Polecats cannot sling - check early before writing anything
 	if polecatName := os.Getenv("GT_POLECAT"); polecatName != "" {
-		return fmt.Errorf("polecats cannot sling (use gt done for handoff)")
+		return slingUserError(fmt.Errorf("polecats cannot sling (use gt done for handoff)"))
 	}
 
 	// Get town root early - needed for BEADS_DIR when running bd commands
@@ -129,17 +169,34 @@ func runSling(cmd *cobra.Command, args []string) error {
 	}
 	townBeadsDir := filepath.Join(townRoot, ".beads")
 
+	onExisting, err := parseOnExistingPolicy(slingOnExisting)
+	if err != nil {
+		return err
+	}
+
+	// Resolve --no-convoy against the town's sling.auto_convoy default so the
+	// single and batch paths below agree.
+	noConvoyChanged := cmd != nil && cmd.Flags().Changed("no-convoy")
+	slingNoConvoy = !autoConvoyEnabled(townRoot, slingNoConvoy, noConvoyChanged)
+	slingSpawnRate = spawnRate(townRoot, slingSpawnRate, cmd != nil && cmd.Flags().Changed("spawn-rate"))
+
 	// --var is only for standalone formula mode, not formula-on-bead mode
 	if slingOnTarget != "" && len(slingVars) > 0 {
-		return fmt.Errorf("--var cannot be used with --on (formula-on-bead mode doesn't support variables)")
+		return slingUserError(fmt.Errorf("--var cannot be used with --on (formula-on-bead mode doesn't support variables)"))
 	}
 
 	// Batch mode detection: multiple beads with rig target
 	// Pattern: gt sling gt-abc gt-def gt-ghi gastown
 	// When len(args) > 2 and last arg is a rig, sling each bead to its own polecat
 	if len(args) > 2 {
-		lastArg := args[len(args)-1]
-		if rigName, isRig := IsRigName(lastArg); isRig {
+		rigName, asRig, err := resolveSlingRigTarget(townRoot, args[len(args)-1])
+		if err != nil {
+			return err
+		}
+		if asRig {
+			if slingAt != "" {
+				return scheduleSlings(townRoot, args[:len(args)-1], rigName)
+			}
 			return runBatchSling(args[:len(args)-1], rigName, townBeadsDir)
 		}
 	}
@@ -153,7 +210,7 @@ func runSling(cmd *cobra.Command, args []string) error {
 		formulaName = args[0]
 		beadID = slingOnTarget
 		// Verify both exist
-		if err := verifyBeadExists(beadID); err != nil {
+		if err := verifyBeadExists(townRoot, beadID); err != nil {
 			return err
 		}
 		if err := verifyFormulaExists(formulaName); err != nil {
@@ -163,8 +220,10 @@ func runSling(cmd *cobra.Command, args []string) error {
 		// Could be bead mode or standalone formula mode
 		firstArg := args[0]
 
-		// Try as bead first
-		if err := verifyBeadExists(firstArg); err == nil {
+		// Try as bead first (--bead: it is one, whatever else matches)
+		if slingAsBead {
+			beadID = firstArg
+		} else if err := verifyBeadExists(townRoot, firstArg); err == nil {
 			// It's a verified bead
 			beadID = firstArg
 		} else {
@@ -180,15 +239,32 @@ func runSling(cmd *cobra.Command, args []string) error {
 				beadID = firstArg
 			} else {
 				// Neither bead nor formula
-				return fmt.Errorf("'%s' is not a valid bead or formula", firstArg)
+				return slingUserError(fmt.Errorf("'%s' is not a valid bead or formula", firstArg))
 			}
 		}
 	}
 
+	if slingAt != "" {
+		if formulaName != "" {
+			return slingUserError(fmt.Errorf("--at cannot be used with --on"))
+		}
+		var target string
+		if len(args) > 1 {
+			target = args[1]
+		}
+		return scheduleSlings(townRoot, []string{beadID}, target)
+	}
+
+	if slingExpand {
+		return runSlingExpand(args, beadID, formulaName, townRoot)
+	}
+
 	// Determine target agent (self or specified)
 	var targetAgent string
 	var targetPane string
-	var hookWorkDir string // Working directory for running bd hook commands
+	var hookWorkDir string          // Working directory for running bd hook commands
+	var existingTarget bool         // Target is an already-running agent (not freshly spawned)
+	var spawned *SpawnedPolecatInfo // Polecat spawned for this sling, if any
 
 	if len(args) > 1 {
 		target := args[1]
@@ -213,7 +289,7 @@ func runSling(cmd *cobra.Command, args []string) error {
 				targetPane = "<dog-pane>"
 			} else {
 				// Dispatch to dog
-				dispatchInfo, dispatchErr := DispatchToDog(dogName, slingCreate)
+				dispatchInfo, dispatchErr := DispatchToDog(townRoot, dogName, beadID, slingCreate)
 				if dispatchErr != nil {
 					return fmt.Errorf("dispatching to dog: %w", dispatchErr)
 				}
@@ -221,7 +297,9 @@ func runSling(cmd *cobra.Command, args []string) error {
 				targetPane = dispatchInfo.Pane
 				fmt.Printf("Dispatched to dog %s\n", dispatchInfo.DogName)
 			}
-		} else if rigName, isRig := IsRigName(target); isRig {
+		} else if rigName, asRig, rigErr := resolveSlingRigTarget(townRoot, target); rigErr != nil {
+			return rigErr
+		} else if asRig {
 			// Check if target is a rig name (auto-spawn polecat)
 			if slingDryRun {
 				// Dry run - just indicate what would happen
@@ -229,6 +307,11 @@ func runSling(cmd *cobra.Command, args []string) error {
 				targetAgent = fmt.Sprintf("%s/polecats/<new>", rigName)
 				targetPane = "<new-pane>"
 			} else {
+				// Catch a cross-database misconfiguration before spawning,
+				// rather than leaving an orphan polecat behind
+				if err := validateRigAgentPrefix(townRoot, rigName); err != nil {
+					return err
+				}
 				// Spawn a fresh polecat in the rig
 				fmt.Printf("Target is rig '%s', spawning fresh polecat...\n", rigName)
 				spawnOpts := SlingSpawnOptions{
@@ -242,50 +325,49 @@ func runSling(cmd *cobra.Command, args []string) error {
 				if spawnErr != nil {
 					return fmt.Errorf("spawning polecat: %w", spawnErr)
 				}
+				spawned = spawnInfo
 				targetAgent = spawnInfo.AgentID()
 				targetPane = spawnInfo.Pane
 				hookWorkDir = spawnInfo.ClonePath // Run bd commands from polecat's worktree
 
 				// Wake witness and refinery to monitor the new polecat
-				wakeRigAgents(rigName)
+				reportWake(wakeRigAgents(rigName))
 			}
 		} else {
 			// Slinging to an existing agent
 			var targetWorkDir string
-			targetAgent, targetPane, targetWorkDir, err = resolveTargetAgent(target)
+			targetAgent, targetPane, targetWorkDir, err = resolveTargetAgent(townRoot, target)
 			if err != nil {
-				// Check if this is a dead polecat (no active session)
-				// If so, spawn a fresh polecat instead of failing
-				if isPolecatTarget(target) {
-					// Extract rig name from polecat target (format: rig/polecats/name)
-					parts := strings.Split(target, "/")
-					if len(parts) >= 3 && parts[1] == "polecats" {
-						rigName := parts[0]
-						fmt.Printf("Target polecat has no active session, spawning fresh polecat in rig '%s'...\n", rigName)
-						spawnOpts := SlingSpawnOptions{
-							Force:    slingForce,
-							Account:  slingAccount,
-							Create:   slingCreate,
-							HookBead: beadID,
-							Agent:    slingAgent,
-						}
-						spawnInfo, spawnErr := SpawnPolecatForSling(rigName, spawnOpts)
-						if spawnErr != nil {
-							return fmt.Errorf("spawning polecat to replace dead polecat: %w", spawnErr)
-						}
-						targetAgent = spawnInfo.AgentID()
-						targetPane = spawnInfo.Pane
-						hookWorkDir = spawnInfo.ClonePath
-
-						// Wake witness and refinery to monitor the new polecat
-						wakeRigAgents(rigName)
-					} else {
-						return fmt.Errorf("resolving target: %w", err)
-					}
-				} else {
+				// A dead polecat (no active session) or a crew with nobody
+				// idle gets a fresh polecat instead of failing
+				rigName, reason := slingSpawnFallback(target, err)
+				if rigName == "" {
 					return fmt.Errorf("resolving target: %w", err)
 				}
+				if err := validateRigAgentPrefix(townRoot, rigName); err != nil {
+					return err
+				}
+				fmt.Printf("%s, spawning fresh polecat in rig '%s'...\n", reason, rigName)
+				spawnOpts := SlingSpawnOptions{
+					Force:    slingForce,
+					Account:  slingAccount,
+					Create:   slingCreate,
+					HookBead: beadID,
+					Agent:    slingAgent,
+				}
+				spawnInfo, spawnErr := SpawnPolecatForSling(rigName, spawnOpts)
+				if spawnErr != nil {
+					return fmt.Errorf("spawning polecat: %w", spawnErr)
+				}
+				spawned = spawnInfo
+				targetAgent = spawnInfo.AgentID()
+				targetPane = spawnInfo.Pane
+				hookWorkDir = spawnInfo.ClonePath
+
+				// Wake witness and refinery to monitor the new polecat
+				reportWake(wakeRigAgents(rigName))
 			}
+			existingTarget = err == nil
 			// Use target's working directory for bd commands (needed for redirect-based routing)
 			if targetWorkDir != "" {
 				hookWorkDir = targetWorkDir
@@ -304,6 +386,12 @@ func runSling(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	// Catch a cross-database misconfiguration before the formula steps and
+	// hooking, rather than as a failed slot set afterwards
+	if err := validateAgentBeadPrefix(townRoot, targetAgent); err != nil {
+		return err
+	}
+
 	// Display what we're doing
 	if formulaName != "" {
 		fmt.Printf("%s Slinging formula %s on %s to %s...\n", style.Bold.Render("🎯"), formulaName, beadID, targetAgent)
@@ -312,7 +400,7 @@ func runSling(cmd *cobra.Command, args []string) error {
 	}
 
 	// Check if bead is already pinned (guard against accidental re-sling)
-	info, err := getBeadInfo(beadID)
+	info, err := getBeadInfo(townRoot, beadID)
 	if err != nil {
 		return fmt.Errorf("checking bead status: %w", err)
 	}
@@ -321,22 +409,44 @@ func runSling(cmd *cobra.Command, args []string) error {
 		if assignee == "" {
 			assignee = "(unknown)"
 		}
-		return fmt.Errorf("bead %s is already pinned to %s\nUse --force to re-sling", beadID, assignee)
+		return slingUserError(fmt.Errorf("bead %s is already pinned to %s\nUse --force to re-sling", beadID, assignee))
+	}
+	if info.Type == "epic" && formulaName == "" {
+		fmt.Printf("  %s %s is an epic; use --expand to sling its ready children instead\n", style.Dim.Render("○"), beadID)
+	}
+
+	// Apply the --on-existing policy when the target polecat is already busy
+	var existingBeads *beads.Beads
+	var replaced []string
+	slungBeadID := beadID
+	if existingTarget && !slingDryRun && strings.Contains(targetAgent, "/polecats/") {
+		existingBeads = beadsForBead(townRoot, beadID, hookWorkDir)
+		queued, replace, err := applyOnExisting(existingBeads, onExisting, targetAgent, beadID)
+		if err != nil {
+			return err
+		}
+		replaced = replace
+		if queued {
+			fmt.Printf("%s Queued %s for %s (behind current hooked work)\n", style.Bold.Render("✓"), beadID, targetAgent)
+			return nil
+		}
 	}
 
 	// Auto-convoy: check if issue is already tracked by a convoy
 	// If not, create one for dashboard visibility (unless --no-convoy is set)
 	if !slingNoConvoy && formulaName == "" {
-		existingConvoy := isTrackedByConvoy(beadID)
+		existingConvoy := isTrackedByConvoy(townRoot, beadID)
 		if existingConvoy == "" {
 			if slingDryRun {
 				fmt.Printf("Would create convoy 'Work: %s'\n", info.Title)
 				fmt.Printf("Would add tracking relation to %s\n", beadID)
 			} else {
-				convoyID, err := createAutoConvoy(beadID, info.Title)
+				convoyID, created, err := createAutoConvoy(townRoot, beadID, info.Title)
 				if err != nil {
 					// Log warning but don't fail - convoy is optional
 					fmt.Printf("%s Could not create auto-convoy: %v\n", style.Dim.Render("Warning:"), err)
+				} else if !created {
+					fmt.Printf("%s Already tracked by convoy %s\n", style.Dim.Render("○"), convoyID)
 				} else {
 					fmt.Printf("%s Created convoy 🚚 %s\n", style.Bold.Render("→"), convoyID)
 					fmt.Printf("  Tracking: %s\n", beadID)
@@ -352,7 +462,7 @@ func runSling(cmd *cobra.Command, args []string) error {
 			fmt.Printf("Would instantiate formula %s:\n", formulaName)
 			fmt.Printf("  1. bd cook %s\n", formulaName)
 			fmt.Printf("  2. bd mol wisp %s --var feature=\"%s\" --var issue=\"%s\"\n", formulaName, info.Title, beadID)
-			fmt.Printf("  3. bd mol bond <wisp-root> %s\n", beadID)
+			fmt.Printf("  3. bd dep add <wisp-root> %s --type=tracks\n", beadID)
 			fmt.Printf("  4. bd update <compound-root> --status=hooked --assignee=%s\n", targetAgent)
 		} else {
 			fmt.Printf("Would run: bd update %s --status=hooked --assignee=%s\n", beadID, targetAgent)
@@ -413,59 +523,59 @@ func runSling(cmd *cobra.Command, args []string) error {
 		}
 		fmt.Printf("%s Formula wisp created: %s\n", style.Bold.Render("✓"), wispRootID)
 
-		// Step 3: Bond wisp to original bead (creates compound)
-		// Use --no-daemon for mol bond (requires direct database access)
-		bondArgs := []string{"--no-daemon", "mol", "bond", wispRootID, beadID, "--json"}
-		bondCmd := exec.Command("bd", bondArgs...)
-		bondCmd.Dir = formulaWorkDir
-		bondCmd.Stderr = os.Stderr
-		bondOut, err := bondCmd.Output()
-		if err != nil {
+		// Step 3: Attach the wisp to the original bead. A tracks relation
+		// rather than bd mol bond, whose blocking edge would hide the bead
+		// from bd ready until the wisp closes. The wisp root is hooked.
+		if _, err := beads.New(formulaWorkDir).MolBondTracks(wispRootID, beadID); err != nil {
 			return fmt.Errorf("bonding formula to bead: %w", err)
 		}
 
-		// Parse bond output - the wisp root becomes the compound root
-		// After bonding, we hook the wisp root (which now contains the original bead)
-		var bondResult struct {
-			RootID string `json:"root_id"`
-		}
-		if err := json.Unmarshal(bondOut, &bondResult); err != nil {
-			// Fallback: use wisp root as the compound root
-			fmt.Printf("%s Could not parse bond output, using wisp root\n", style.Dim.Render("Warning:"))
-		} else if bondResult.RootID != "" {
-			wispRootID = bondResult.RootID
-		}
-
 		fmt.Printf("%s Formula bonded to %s\n", style.Bold.Render("✓"), beadID)
 
 		// Record the attached molecule in the wisp's description.
 		// This is required for gt hook to recognize the molecule attachment.
-		if err := storeAttachedMoleculeInBead(wispRootID, wispRootID); err != nil {
+		wispBeads := beadsForBead(townRoot, wispRootID, formulaWorkDir)
+		if err := wispBeads.SetAttachedMolecule(wispRootID, wispRootID); err != nil {
 			// Warn but don't fail - polecat can still work through steps
 			fmt.Printf("%s Could not store attached_molecule: %v\n", style.Dim.Render("Warning:"), err)
 		}
+		if err := wispBeads.SetWispLineage(wispRootID, formulaName, beadID); err != nil {
+			fmt.Printf("%s Could not store wisp lineage: %v\n", style.Dim.Render("Warning:"), err)
+		}
 
 		// Update beadID to hook the compound root instead of bare bead
 		beadID = wispRootID
 	}
 
-	// Hook the bead using bd update.
-	// See: https://github.com/steveyegge/gastown/issues/148
-	hookCmd := exec.Command("bd", "--no-daemon", "update", beadID, "--status=hooked", "--assignee="+targetAgent)
-	hookCmd.Dir = beads.ResolveHookDir(townRoot, beadID, hookWorkDir)
-	hookCmd.Stderr = os.Stderr
-	if err := hookCmd.Run(); err != nil {
+	// Hook the bead in its own database. A polecat spawned for a bead it
+	// then can't claim is removed rather than left idle.
+	hookBeads := beadsForBead(townRoot, beadID, hookWorkDir)
+	if err := claimBead(hookBeads, beadID, targetAgent, slingForce); err != nil {
+		if spawned != nil {
+			if discardErr := discardSpawnedPolecat(spawned); discardErr != nil {
+				fmt.Printf("%s Could not remove polecat %s: %v\n", style.Dim.Render("Warning:"), spawned.PolecatName, discardErr)
+			}
+		}
 		return fmt.Errorf("hooking bead: %w", err)
 	}
 
 	fmt.Printf("%s Work attached to hook (status=hooked)\n", style.Bold.Render("✓"))
 
 	// Log sling event to activity feed
-	actor := detectActor()
+	actor := ResolveActor("")
 	_ = events.LogFeed(events.TypeSling, actor, events.SlingPayload(beadID, targetAgent))
 
 	// Update agent bead's hook_bead field (ZFC: agents track their current work)
-	updateAgentHookBead(targetAgent, beadID, hookWorkDir, townBeadsDir)
+	updateAgentHookBead(townRoot, targetAgent, beadID, hookWorkDir)
+
+	// --on-existing=replace: the new bead is hooked, release what it displaced
+	if len(replaced) > 0 {
+		if err := releaseReplaced(existingBeads, replaced, slungBeadID); err != nil {
+			fmt.Printf("%s Could not release replaced work: %v\n", style.Dim.Render("Warning:"), err)
+		} else {
+			fmt.Printf("%s Released %s (replaced)\n", style.Bold.Render("✓"), strings.Join(replaced, ", "))
+		}
+	}
 
 	// Auto-attach mol-polecat-work to polecat agent beads
 	// This ensures polecats have the standard work molecule attached for guidance
@@ -476,21 +586,8 @@ func runSling(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	// Store dispatcher in bead description (enables completion notification to dispatcher)
-	if err := storeDispatcherInBead(beadID, actor); err != nil {
-		// Warn but don't fail - polecat will still complete work
-		fmt.Printf("%s Could not store dispatcher in bead: %v\n", style.Dim.Render("Warning:"), err)
-	}
-
-	// Store args in bead description (no-tmux mode: beads as data plane)
-	if slingArgs != "" {
-		if err := storeArgsInBead(beadID, slingArgs); err != nil {
-			// Warn but don't fail - args will still be in the nudge prompt
-			fmt.Printf("%s Could not store args in bead: %v\n", style.Dim.Render("Warning:"), err)
-		} else {
-			fmt.Printf("%s Args stored in bead (durable)\n", style.Bold.Render("✓"))
-		}
-	}
+	// Store dispatcher and args in bead description (no-tmux mode: beads as data plane)
+	recordSlingMetadata(hookBeads, beadID, actor, slingArgs, "")
 
 	// Try to inject the "start now" prompt (graceful if no tmux)
 	if targetPane == "" {
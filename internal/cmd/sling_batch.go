@@ -1,31 +1,116 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
-	"os"
-	"os/exec"
 	"path/filepath"
 
-	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/events"
+	"github.com/steveyegge/gastown/internal/rig"
 	"github.com/steveyegge/gastown/internal/style"
 )
 
+// spawnPolecatForBatch spawns a polecat for one bead of a batch sling.
+// It is a variable so tests can simulate spawn failures.
+var spawnPolecatForBatch = SpawnPolecatForSling
+
+// isUnrecoverableSlingError reports whether a spawn error will recur for every
+// remaining bead in a batch (misconfigured rig, budget or capacity exhausted),
+// as opposed to a per-bead failure such as an already pinned bead.
+func isUnrecoverableSlingError(err error) bool {
+	return errors.Is(err, rig.ErrRigNotFound) ||
+		errors.Is(err, ErrRigAtCapacity) ||
+		errors.Is(err, ErrBudgetExceeded)
+}
+
+// dedupeBeadIDs drops repeated bead IDs, keeping the first occurrence of
+// each, and reports how many were dropped.
+func dedupeBeadIDs(beadIDs []string) (unique []string, dropped int) {
+	seen := make(map[string]bool, len(beadIDs))
+	unique = make([]string, 0, len(beadIDs))
+	for _, id := range beadIDs {
+		if seen[id] {
+			dropped++
+			continue
+		}
+		seen[id] = true
+		unique = append(unique, id)
+	}
+	return unique, dropped
+}
+
+// BatchProgressKind identifies a per-bead batch sling event.
+type BatchProgressKind string
+
+const (
+	BatchStarted   BatchProgressKind = "started"
+	BatchSucceeded BatchProgressKind = "succeeded"
+	BatchFailed    BatchProgressKind = "failed"
+)
+
+// BatchProgress is emitted for each bead as a batch sling proceeds.
+// Every bead gets exactly one terminal event (succeeded or failed), after a
+// started event per spawn attempt; beads skipped by --fail-fast before their
+// first attempt fail without a started event.
+type BatchProgress struct {
+	Kind      BatchProgressKind
+	BeadID    string
+	Polecat   string // Set on success
+	Error     string // Set on failure
+	Attempts  int    // Spawn attempts made for this bead so far
+	Done      int    // Beads finished so far, including this one
+	Succeeded int
+	Failed    int
+	Total     int
+}
+
 // runBatchSling handles slinging multiple beads to a rig.
-// Each bead gets its own freshly spawned polecat.
+// Each bead gets its own freshly spawned polecat. A bead whose spawn fails
+// recoverably is requeued behind the rest of the batch until it has had
+// --max-attempts tries. With --fail-fast, an unrecoverable spawn error stops
+// the batch and the remaining beads are skipped.
 func runBatchSling(beadIDs []string, rigName string, townBeadsDir string) error {
+	return runBatchSlingWithProgress(beadIDs, rigName, townBeadsDir, nil)
+}
+
+// runBatchSlingWithProgress is runBatchSling with live progress reporting.
+// If progress is non-nil, a BatchProgress is sent for every state change;
+// sends block, so the caller must drain the channel. It is not closed.
+func runBatchSlingWithProgress(beadIDs []string, rigName string, townBeadsDir string, progress chan<- BatchProgress) error {
+	townRoot := filepath.Dir(townBeadsDir)
+
+	// A bead named twice would otherwise get two polecats
+	beadIDs, dropped := dedupeBeadIDs(beadIDs)
+	if dropped > 0 {
+		fmt.Printf("%s Ignoring %d duplicate bead ID(s)\n", style.Dim.Render("○"), dropped)
+	}
+
 	// Validate all beads exist before spawning any polecats
 	for _, beadID := range beadIDs {
-		if err := verifyBeadExists(beadID); err != nil {
+		if err := verifyBeadExists(townRoot, beadID); err != nil {
 			return fmt.Errorf("bead '%s' not found", beadID)
 		}
 	}
 
+	// Every polecat in the batch gets an agent bead with the rig's prefix,
+	// so check it once before spawning any of them
+	if err := validateRigAgentPrefix(townRoot, rigName); err != nil {
+		return err
+	}
+
 	if slingDryRun {
+		slots := -1
+		if !slingForce {
+			slots = rigSpawnSlots(townRoot, rigName)
+		}
+		plan := planBatchSling(beadIDs, slots)
 		fmt.Printf("%s Batch slinging %d beads to rig '%s':\n", style.Bold.Render("🎯"), len(beadIDs), rigName)
-		for _, beadID := range beadIDs {
+		for _, beadID := range plan.Spawn {
 			fmt.Printf("  Would spawn polecat for: %s\n", beadID)
 		}
+		for _, beadID := range plan.Refused {
+			fmt.Printf("  Would refuse (rig at capacity): %s\n", beadID)
+		}
 		return nil
 	}
 
@@ -33,27 +118,76 @@ func runBatchSling(beadIDs []string, rigName string, townBeadsDir string) error
 
 	// Track results for summary
 	type slingResult struct {
-		beadID  string
-		polecat string
-		success bool
-		errMsg  string
+		beadID   string
+		polecat  string
+		success  bool
+		errMsg   string
+		attempts int
 	}
 	results := make([]slingResult, 0, len(beadIDs))
+	attempts := make(map[string]int, len(beadIDs)) // spawn attempts per bead
+	var abortErr error
+	succeeded, failed := 0, 0
 
-	// Spawn a polecat for each bead and sling it
-	for i, beadID := range beadIDs {
-		fmt.Printf("\n[%d/%d] Slinging %s...\n", i+1, len(beadIDs), beadID)
+	emit := func(kind BatchProgressKind, r slingResult) {
+		if progress == nil {
+			return
+		}
+		progress <- BatchProgress{
+			Kind:      kind,
+			BeadID:    r.beadID,
+			Polecat:   r.polecat,
+			Error:     r.errMsg,
+			Attempts:  r.attempts,
+			Done:      succeeded + failed,
+			Succeeded: succeeded,
+			Failed:    failed,
+			Total:     len(beadIDs),
+		}
+	}
+	record := func(r slingResult) {
+		r.attempts = attempts[r.beadID]
+		results = append(results, r)
+		kind := BatchSucceeded
+		if r.success {
+			succeeded++
+		} else {
+			failed++
+			kind = BatchFailed
+		}
+		emit(kind, r)
+	}
+
+	// Pace spawns so a large batch doesn't hit the API and git all at once
+	limiter := newSpawnLimiter(slingSpawnRate)
+
+	maxAttempts := slingAttempts
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+	// Spawn a polecat for each bead and sling it. IDs are unique and requeued
+	// beads are appended, so each bead is pending at most once.
+	queue := append([]string(nil), beadIDs...)
+	for i := 0; i < len(queue); i++ {
+		beadID := queue[i]
+		attempts[beadID]++
+		if attempts[beadID] == 1 {
+			fmt.Printf("\n[%d/%d] Slinging %s...\n", i+1, len(beadIDs), beadID)
+		} else {
+			fmt.Printf("\nRetrying %s (attempt %d/%d)...\n", beadID, attempts[beadID], maxAttempts)
+		}
+		emit(BatchStarted, slingResult{beadID: beadID, attempts: attempts[beadID]})
 
 		// Check bead status
-		info, err := getBeadInfo(beadID)
+		info, err := getBeadInfo(townRoot, beadID)
 		if err != nil {
-			results = append(results, slingResult{beadID: beadID, success: false, errMsg: err.Error()})
+			record(slingResult{beadID: beadID, success: false, errMsg: err.Error()})
 			fmt.Printf("  %s Could not get bead info: %v\n", style.Dim.Render("✗"), err)
 			continue
 		}
 
 		if info.Status == "pinned" && !slingForce {
-			results = append(results, slingResult{beadID: beadID, success: false, errMsg: "already pinned"})
+			record(slingResult{beadID: beadID, success: false, errMsg: "already pinned"})
 			fmt.Printf("  %s Already pinned (use --force to re-sling)\n", style.Dim.Render("✗"))
 			continue
 		}
@@ -66,10 +200,23 @@ func runBatchSling(beadIDs []string, rigName string, townBeadsDir string) error
 			HookBead: beadID, // Set atomically at spawn time
 			Agent:    slingAgent,
 		}
-		spawnInfo, err := SpawnPolecatForSling(rigName, spawnOpts)
+		limiter.Wait()
+		spawnInfo, err := spawnPolecatForBatch(rigName, spawnOpts)
 		if err != nil {
-			results = append(results, slingResult{beadID: beadID, success: false, errMsg: err.Error()})
 			fmt.Printf("  %s Failed to spawn polecat: %v\n", style.Dim.Render("✗"), err)
+			if !isUnrecoverableSlingError(err) && attempts[beadID] < maxAttempts {
+				fmt.Printf("  %s Requeued for a later pass\n", style.Dim.Render("↻"))
+				queue = append(queue, beadID)
+				continue
+			}
+			record(slingResult{beadID: beadID, success: false, errMsg: err.Error()})
+			if slingFailFast && isUnrecoverableSlingError(err) {
+				abortErr = fmt.Errorf("batch sling stopped at %s: %w", beadID, err)
+				for _, skipped := range queue[i+1:] {
+					record(slingResult{beadID: skipped, success: false, errMsg: "skipped (--fail-fast)"})
+				}
+				break
+			}
 			continue
 		}
 
@@ -78,11 +225,13 @@ func runBatchSling(beadIDs []string, rigName string, townBeadsDir string) error
 
 		// Auto-convoy: check if issue is already tracked
 		if !slingNoConvoy {
-			existingConvoy := isTrackedByConvoy(beadID)
+			existingConvoy := isTrackedByConvoy(townRoot, beadID)
 			if existingConvoy == "" {
-				convoyID, err := createAutoConvoy(beadID, info.Title)
+				convoyID, created, err := createAutoConvoy(townRoot, beadID, info.Title)
 				if err != nil {
 					fmt.Printf("  %s Could not create auto-convoy: %v\n", style.Dim.Render("Warning:"), err)
+				} else if !created {
+					fmt.Printf("  %s Already tracked by convoy %s\n", style.Dim.Render("○"), convoyID)
 				} else {
 					fmt.Printf("  %s Created convoy 🚚 %s\n", style.Bold.Render("→"), convoyID)
 				}
@@ -91,37 +240,33 @@ func runBatchSling(beadIDs []string, rigName string, townBeadsDir string) error
 			}
 		}
 
-		// Hook the bead. See: https://github.com/steveyegge/gastown/issues/148
-		townRoot := filepath.Dir(townBeadsDir)
-		hookCmd := exec.Command("bd", "--no-daemon", "update", beadID, "--status=hooked", "--assignee="+targetAgent)
-		hookCmd.Dir = beads.ResolveHookDir(townRoot, beadID, hookWorkDir)
-		hookCmd.Stderr = os.Stderr
-		if err := hookCmd.Run(); err != nil {
-			results = append(results, slingResult{beadID: beadID, polecat: spawnInfo.PolecatName, success: false, errMsg: "hook failed"})
+		// Hook the bead in its own database
+		hookBeads := beadsForBead(townRoot, beadID, hookWorkDir)
+		if err := claimBead(hookBeads, beadID, targetAgent, slingForce); err != nil {
+			record(slingResult{beadID: beadID, polecat: spawnInfo.PolecatName, success: false, errMsg: "hook failed"})
 			fmt.Printf("  %s Failed to hook bead: %v\n", style.Dim.Render("✗"), err)
+			if err := discardSpawnedPolecat(spawnInfo); err != nil {
+				fmt.Printf("  %s Could not remove polecat %s: %v\n", style.Dim.Render("Warning:"), spawnInfo.PolecatName, err)
+			}
 			continue
 		}
 
 		fmt.Printf("  %s Work attached to %s\n", style.Bold.Render("✓"), spawnInfo.PolecatName)
 
 		// Log sling event
-		actor := detectActor()
+		actor := ResolveActor("")
 		_ = events.LogFeed(events.TypeSling, actor, events.SlingPayload(beadID, targetAgent))
 
 		// Update agent bead state
-		updateAgentHookBead(targetAgent, beadID, hookWorkDir, townBeadsDir)
+		updateAgentHookBead(townRoot, targetAgent, beadID, hookWorkDir)
 
 		// Auto-attach mol-polecat-work molecule to polecat agent bead
 		if err := attachPolecatWorkMolecule(targetAgent, hookWorkDir, townRoot); err != nil {
 			fmt.Printf("  %s Could not attach work molecule: %v\n", style.Dim.Render("Warning:"), err)
 		}
 
-		// Store args if provided
-		if slingArgs != "" {
-			if err := storeArgsInBead(beadID, slingArgs); err != nil {
-				fmt.Printf("  %s Could not store args: %v\n", style.Dim.Render("Warning:"), err)
-			}
-		}
+		// Store dispatcher and args
+		recordSlingMetadata(hookBeads, beadID, actor, slingArgs, "  ")
 
 		// Nudge the polecat
 		if spawnInfo.Pane != "" {
@@ -132,28 +277,29 @@ func runBatchSling(beadIDs []string, rigName string, townBeadsDir string) error
 			}
 		}
 
-		results = append(results, slingResult{beadID: beadID, polecat: spawnInfo.PolecatName, success: true})
+		record(slingResult{beadID: beadID, polecat: spawnInfo.PolecatName, success: true})
 	}
 
-	// Wake witness and refinery once at the end
-	wakeRigAgents(rigName)
-
-	// Print summary
-	successCount := 0
-	for _, r := range results {
-		if r.success {
-			successCount++
-		}
+	// Wake witness and refinery once at the end (pointless if the rig itself is broken)
+	var wake WakeResult
+	if !errors.Is(abortErr, rig.ErrRigNotFound) {
+		wake = wakeRigAgents(rigName)
 	}
 
-	fmt.Printf("\n%s Batch sling complete: %d/%d succeeded\n", style.Bold.Render("📊"), successCount, len(beadIDs))
-	if successCount < len(beadIDs) {
+	// Print summary
+	fmt.Printf("\n%s Batch sling complete: %d/%d succeeded\n", style.Bold.Render("📊"), succeeded, len(beadIDs))
+	if succeeded < len(beadIDs) {
 		for _, r := range results {
 			if !r.success {
-				fmt.Printf("  %s %s: %s\n", style.Dim.Render("✗"), r.beadID, r.errMsg)
+				if r.attempts > 1 {
+					fmt.Printf("  %s %s: %s (after %d attempts)\n", style.Dim.Render("✗"), r.beadID, r.errMsg, r.attempts)
+				} else {
+					fmt.Printf("  %s %s: %s\n", style.Dim.Render("✗"), r.beadID, r.errMsg)
+				}
 			}
 		}
 	}
+	reportWake(wake)
 
-	return nil
+	return abortErr
 }
@@ -0,0 +1,241 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/rig"
+)
+
+// stubBatchSling installs a bd stub reporting every bead as open and an
+// unusable tmux (so the final rig wake-up is skipped), and replaces the batch
+// spawner with one that records calls and returns spawnErr. It also moves to
+// an empty directory: sling events are logged to the town found from the
+// working directory, and internal/ looks like a town root.
+func stubBatchSling(t *testing.T, spawnErr error) *[]string {
+	t.Helper()
+	t.Chdir(t.TempDir())
+	binDir := t.TempDir()
+	script := "#!/bin/sh\nprintf '%s\\n' '[{\"title\":\"Work\",\"status\":\"open\",\"assignee\":\"\"}]'\n"
+	if err := os.WriteFile(filepath.Join(binDir, "bd"), []byte(script), 0755); err != nil {
+		t.Fatalf("write bd stub: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(binDir, "tmux"), []byte("#!/bin/sh\nexit 1\n"), 0755); err != nil {
+		t.Fatalf("write tmux stub: %v", err)
+	}
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	var spawned []string
+	prevSpawn, prevFailFast, prevDryRun, prevAttempts := spawnPolecatForBatch, slingFailFast, slingDryRun, slingAttempts
+	spawnPolecatForBatch = func(rigName string, opts SlingSpawnOptions) (*SpawnedPolecatInfo, error) {
+		spawned = append(spawned, opts.HookBead)
+		return nil, spawnErr
+	}
+	slingDryRun = false
+	slingAttempts = 1
+	t.Cleanup(func() {
+		spawnPolecatForBatch, slingFailFast, slingDryRun, slingAttempts = prevSpawn, prevFailFast, prevDryRun, prevAttempts
+	})
+	return &spawned
+}
+
+func TestBatchSlingFailFastStopsOnUnrecoverableError(t *testing.T) {
+	spawned := stubBatchSling(t, fmt.Errorf("rig 'nowhere' not found: %w", rig.ErrRigNotFound))
+	slingFailFast = true
+
+	err := runBatchSling([]string{"gt-a", "gt-b", "gt-c"}, "nowhere", t.TempDir())
+	if err == nil {
+		t.Fatal("runBatchSling() should fail when --fail-fast aborts the batch")
+	}
+	if len(*spawned) != 1 || (*spawned)[0] != "gt-a" {
+		t.Errorf("spawned = %v, want only gt-a", *spawned)
+	}
+}
+
+func TestBatchSlingContinuesPastRecoverableError(t *testing.T) {
+	spawned := stubBatchSling(t, fmt.Errorf("polecat 'Toast' has uncommitted work"))
+	slingFailFast = true
+
+	if err := runBatchSling([]string{"gt-a", "gt-b", "gt-c"}, "gastown", t.TempDir()); err != nil {
+		t.Fatalf("runBatchSling() = %v, want per-bead failures only", err)
+	}
+	if len(*spawned) != 3 {
+		t.Errorf("spawned = %v, want all three beads attempted", *spawned)
+	}
+}
+
+func TestBatchSlingProgressEvents(t *testing.T) {
+	stubBatchSling(t, fmt.Errorf("rig 'nowhere' not found: %w", rig.ErrRigNotFound))
+	slingFailFast = true
+
+	beadIDs := []string{"gt-a", "gt-b", "gt-c"}
+	progress := make(chan BatchProgress, 16)
+	_ = runBatchSlingWithProgress(beadIDs, "nowhere", t.TempDir(), progress)
+	close(progress)
+
+	started := map[string]bool{}
+	terminal := map[string]int{}
+	lastDone := 0
+	var last BatchProgress
+	for ev := range progress {
+		if ev.Total != len(beadIDs) {
+			t.Errorf("%s %s: Total = %d, want %d", ev.Kind, ev.BeadID, ev.Total, len(beadIDs))
+		}
+		if ev.Done != ev.Succeeded+ev.Failed || ev.Done < lastDone {
+			t.Errorf("%s %s: inconsistent totals %+v (previous done %d)", ev.Kind, ev.BeadID, ev, lastDone)
+		}
+		lastDone = ev.Done
+		switch ev.Kind {
+		case BatchStarted:
+			if terminal[ev.BeadID] > 0 {
+				t.Errorf("%s started after finishing", ev.BeadID)
+			}
+			started[ev.BeadID] = true
+		case BatchSucceeded, BatchFailed:
+			terminal[ev.BeadID]++
+		}
+		last = ev
+	}
+
+	// gt-a starts and fails; gt-b and gt-c are skipped by --fail-fast.
+	if !started["gt-a"] || started["gt-b"] || started["gt-c"] {
+		t.Errorf("started = %v, want only gt-a", started)
+	}
+	for _, id := range beadIDs {
+		if terminal[id] != 1 {
+			t.Errorf("%s has %d terminal events, want 1", id, terminal[id])
+		}
+	}
+	if last.Done != len(beadIDs) || last.Failed != len(beadIDs) {
+		t.Errorf("final event = %+v, want all %d done and failed", last, len(beadIDs))
+	}
+}
+
+func TestBatchSlingRequeuesFailedSpawn(t *testing.T) {
+	spawned := stubBatchSling(t, nil)
+	prevNoConvoy := slingNoConvoy
+	slingNoConvoy = true
+	t.Cleanup(func() { slingNoConvoy = prevNoConvoy })
+	slingAttempts = 3
+
+	// gt-a's first spawn fails transiently; everything else succeeds
+	spawnPolecatForBatch = func(rigName string, opts SlingSpawnOptions) (*SpawnedPolecatInfo, error) {
+		*spawned = append(*spawned, opts.HookBead)
+		if len(*spawned) == 1 {
+			return nil, fmt.Errorf("polecat 'Toast' has uncommitted work")
+		}
+		return &SpawnedPolecatInfo{RigName: rigName, PolecatName: "Toast", ClonePath: t.TempDir()}, nil
+	}
+
+	progress := make(chan BatchProgress, 16)
+	if err := runBatchSlingWithProgress([]string{"gt-a", "gt-b"}, "gastown", t.TempDir(), progress); err != nil {
+		t.Fatalf("runBatchSling() = %v", err)
+	}
+	close(progress)
+
+	if got := fmt.Sprint(*spawned); got != "[gt-a gt-b gt-a]" {
+		t.Errorf("spawned = %s, want gt-a requeued behind gt-b", got)
+	}
+	final := map[string]BatchProgress{}
+	for ev := range progress {
+		if ev.Kind != BatchStarted {
+			final[ev.BeadID] = ev
+		}
+	}
+	if ev := final["gt-a"]; ev.Kind != BatchSucceeded || ev.Attempts != 2 {
+		t.Errorf("gt-a final = %+v, want succeeded after 2 attempts", ev)
+	}
+	if ev := final["gt-b"]; ev.Kind != BatchSucceeded || ev.Attempts != 1 {
+		t.Errorf("gt-b final = %+v, want succeeded after 1 attempt", ev)
+	}
+}
+
+func TestBatchSlingGivesUpAfterMaxAttempts(t *testing.T) {
+	spawned := stubBatchSling(t, fmt.Errorf("polecat 'Toast' has uncommitted work"))
+	slingAttempts = 2
+
+	progress := make(chan BatchProgress, 16)
+	_ = runBatchSlingWithProgress([]string{"gt-a"}, "gastown", t.TempDir(), progress)
+	close(progress)
+
+	if len(*spawned) != 2 {
+		t.Errorf("spawned = %v, want 2 attempts", *spawned)
+	}
+	var last BatchProgress
+	for ev := range progress {
+		last = ev
+	}
+	if last.Kind != BatchFailed || last.Attempts != 2 {
+		t.Errorf("final event = %+v, want failed after 2 attempts", last)
+	}
+}
+
+func TestBatchSlingDedupesBeadIDs(t *testing.T) {
+	spawned := stubBatchSling(t, fmt.Errorf("polecat 'Toast' has uncommitted work"))
+
+	progress := make(chan BatchProgress, 16)
+	_ = runBatchSlingWithProgress([]string{"gt-a", "gt-b", "gt-a"}, "gastown", t.TempDir(), progress)
+	close(progress)
+
+	if got := fmt.Sprint(*spawned); got != "[gt-a gt-b]" {
+		t.Errorf("spawned = %s, want one spawn per bead", got)
+	}
+	terminal := map[string]int{}
+	for ev := range progress {
+		if ev.Total != 2 {
+			t.Errorf("%s %s: Total = %d, want 2", ev.Kind, ev.BeadID, ev.Total)
+		}
+		if ev.Kind != BatchStarted {
+			terminal[ev.BeadID]++
+		}
+	}
+	if terminal["gt-a"] != 1 || terminal["gt-b"] != 1 {
+		t.Errorf("terminal events = %v, want one per bead", terminal)
+	}
+}
+
+func TestBatchSlingDiscardsPolecatWhenClaimLost(t *testing.T) {
+	stubBatchSling(t, nil)
+	// Another dispatcher hooked the bead while the polecat was spawning
+	binDir := t.TempDir()
+	script := "#!/bin/sh\nprintf '%s\\n' '[{\"id\":\"gt-a\",\"title\":\"Work\",\"status\":\"hooked\",\"assignee\":\"gastown/polecats/Nux\"}]'\n"
+	if err := os.WriteFile(filepath.Join(binDir, "bd"), []byte(script), 0755); err != nil {
+		t.Fatalf("write bd stub: %v", err)
+	}
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	worktree := t.TempDir()
+	spawnPolecatForBatch = func(rigName string, opts SlingSpawnOptions) (*SpawnedPolecatInfo, error) {
+		return &SpawnedPolecatInfo{RigName: rigName, PolecatName: "Toast", ClonePath: worktree}, nil
+	}
+	var discarded []string
+	prevDiscard, prevNoConvoy := discardSpawnedPolecat, slingNoConvoy
+	discardSpawnedPolecat = func(info *SpawnedPolecatInfo) error {
+		discarded = append(discarded, info.PolecatName)
+		return nil
+	}
+	slingNoConvoy = true
+	t.Cleanup(func() { discardSpawnedPolecat, slingNoConvoy = prevDiscard, prevNoConvoy })
+
+	if err := runBatchSling([]string{"gt-a"}, "gastown", t.TempDir()); err != nil {
+		t.Fatalf("runBatchSling() = %v, want a per-bead failure only", err)
+	}
+	if len(discarded) != 1 || discarded[0] != "Toast" {
+		t.Errorf("discarded = %v, want the polecat spawned for the lost bead", discarded)
+	}
+}
+
+func TestBatchSlingChecksAgentPrefixBeforeSpawning(t *testing.T) {
+	spawned := stubBatchSling(t, nil)
+	townRoot := setupPrefixTown(t, "gt-", `{"prefix":"go-","path":"gastown/mayor/rig"}`)
+
+	err := runBatchSling([]string{"gt-a", "gt-b"}, "gastown", filepath.Join(townRoot, ".beads"))
+	if err == nil {
+		t.Fatal("runBatchSling() should refuse a rig with a misconfigured agent prefix")
+	}
+	if len(*spawned) != 0 {
+		t.Errorf("spawned = %v, want no polecats", *spawned)
+	}
+}
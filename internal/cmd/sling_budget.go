@@ -0,0 +1,80 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/beads"
+)
+
+// ErrBudgetExceeded is returned when a rig's spend for the day has reached
+// its configured cap and new polecats would add to it.
+var ErrBudgetExceeded = errors.New("rig budget exceeded")
+
+// budgetConfigKey returns the town beads config key holding a rig's daily
+// budget in USD (e.g., "budget.daily.gastown").
+func budgetConfigKey(rigName string) string {
+	return "budget.daily." + rigName
+}
+
+// checkRigBudget refuses new polecats for a rig whose spend today has
+// reached its daily cap. Rigs without a cap are never blocked; an unreadable
+// or malformed cap, or spend that can't be read, is warned about and does
+// not block either.
+func checkRigBudget(townRoot, rigName string) error {
+	capUSD, ok, err := rigBudgetCap(townRoot, rigName)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: ignoring budget for %s: %v\n", rigName, err)
+		return nil
+	}
+	if !ok {
+		return nil
+	}
+	spend, err := rigSpendToday(rigName)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: ignoring budget for %s: %v\n", rigName, err)
+		return nil
+	}
+	return enforceRigBudget(rigName, capUSD, spend)
+}
+
+// rigBudgetCap reads a rig's daily cap from town beads config.
+// Returns false if no cap is configured, and an error if the cap can't be
+// read or isn't a positive amount.
+func rigBudgetCap(townRoot, rigName string) (float64, bool, error) {
+	key := budgetConfigKey(rigName)
+	value, err := beads.New(townRoot).GetConfig(key)
+	if err != nil {
+		return 0, false, fmt.Errorf("reading %s: %w", key, err)
+	}
+	if value == "" {
+		return 0, false, nil
+	}
+	capUSD, err := strconv.ParseFloat(strings.TrimPrefix(value, "$"), 64)
+	if err != nil || capUSD <= 0 {
+		return 0, false, fmt.Errorf("config %s: %q is not a positive USD amount", key, value)
+	}
+	return capUSD, true, nil
+}
+
+// rigSpendToday sums today's recorded session costs for a rig.
+func rigSpendToday(rigName string) (float64, error) {
+	entries, err := querySessionCostWisps(time.Now())
+	if err != nil {
+		return 0, fmt.Errorf("reading today's spend: %w", err)
+	}
+	return sumCostsBy(entries, func(e CostEntry) string { return e.Rig })[rigName], nil
+}
+
+// enforceRigBudget returns ErrBudgetExceeded if spend has reached capUSD.
+func enforceRigBudget(rigName string, capUSD, spend float64) error {
+	if spend < capUSD {
+		return nil
+	}
+	return fmt.Errorf("%w: %s has spent $%.2f today (cap $%.2f)\nUse --force to sling anyway, or raise the cap with 'bd config set %s <usd>'",
+		ErrBudgetExceeded, rigName, spend, capUSD, budgetConfigKey(rigName))
+}
@@ -0,0 +1,118 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestEnforceRigBudget(t *testing.T) {
+	tests := []struct {
+		name    string
+		capUSD  float64
+		spend   float64
+		blocked bool
+	}{
+		{"under cap", 10.00, 9.99, false},
+		{"at cap", 10.00, 10.00, true},
+		{"over cap", 10.00, 12.50, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := enforceRigBudget("gastown", tt.capUSD, tt.spend)
+			if got := errors.Is(err, ErrBudgetExceeded); got != tt.blocked {
+				t.Fatalf("enforceRigBudget() = %v, blocked want %v", err, tt.blocked)
+			}
+			if tt.blocked && !strings.Contains(err.Error(), "budget.daily.gastown") {
+				t.Errorf("error should name the config key: %v", err)
+			}
+		})
+	}
+}
+
+// installBudgetStub puts a bd on PATH that reports capValue for the rig's
+// budget key and one session today that cost spend on gastown. Returns the
+// directory holding the stub's canned outputs.
+func installBudgetStub(t *testing.T, capValue string, spend float64) string {
+	t.Helper()
+	dir := t.TempDir()
+	payload, _ := json.Marshal(SessionPayload{CostUSD: spend, Rig: "gastown", EndedAt: time.Now().Format(time.RFC3339)})
+	events, _ := json.Marshal([]SessionEvent{{ID: "w-1", EventKind: "session.ended", Payload: string(payload)}})
+	files := map[string]string{
+		"cap":    capValue,
+		"wisps":  `{"wisps":[{"id":"w-1"}],"count":1}`,
+		"events": string(events),
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content+"\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	script := `#!/bin/sh
+[ "$1" = "--allow-stale" ] && shift
+case "$1" in
+  config) cat "` + dir + `/cap" ;;
+  mol) cat "` + dir + `/wisps" ;;
+  show) cat "` + dir + `/events" ;;
+esac
+`
+	if err := os.WriteFile(filepath.Join(dir, "bd"), []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+	return dir
+}
+
+func TestCheckRigBudget(t *testing.T) {
+	tests := []struct {
+		name     string
+		capValue string
+		blocked  bool
+	}{
+		{"over cap", "$10", true},
+		{"under cap", "20", false},
+		{"no cap", "budget.daily.gastown (not set)", false},
+		{"malformed cap", "ten dollars", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			installBudgetStub(t, tt.capValue, 12.50)
+			err := checkRigBudget(t.TempDir(), "gastown")
+			if got := errors.Is(err, ErrBudgetExceeded); got != tt.blocked {
+				t.Fatalf("checkRigBudget() = %v, blocked want %v", err, tt.blocked)
+			}
+			if tt.blocked && !strings.Contains(err.Error(), "$12.50") {
+				t.Errorf("error should report today's spend: %v", err)
+			}
+		})
+	}
+}
+
+func TestCheckRigBudgetUnreadableSpend(t *testing.T) {
+	dir := installBudgetStub(t, "$10", 12.50)
+	if err := os.WriteFile(filepath.Join(dir, "wisps"), []byte("not json\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := rigSpendToday("gastown"); err == nil {
+		t.Fatal("rigSpendToday() should fail when costs can't be read")
+	}
+	if err := checkRigBudget(t.TempDir(), "gastown"); err != nil {
+		t.Errorf("checkRigBudget() = %v, want a warning only", err)
+	}
+}
+
+func TestRigBudgetCapMalformed(t *testing.T) {
+	for _, value := range []string{"ten dollars", "0", "-5"} {
+		installBudgetStub(t, value, 0)
+		_, ok, err := rigBudgetCap(t.TempDir(), "gastown")
+		if ok || err == nil || !strings.Contains(err.Error(), "budget.daily.gastown") {
+			t.Errorf("rigBudgetCap(%q) = ok %v, err %v; want an error naming the key", value, ok, err)
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/git"
+	"github.com/steveyegge/gastown/internal/polecat"
+	"github.com/steveyegge/gastown/internal/rig"
+	"github.com/steveyegge/gastown/internal/tmux"
+)
+
+// ErrRigAtCapacity is returned when a rig already runs as many polecats as
+// its configured capacity allows.
+var ErrRigAtCapacity = errors.New("rig at polecat capacity")
+
+// capacityConfigKey returns the town beads config key holding a rig's
+// maximum number of concurrently running polecats (e.g., "capacity.polecats.gastown").
+func capacityConfigKey(rigName string) string {
+	return "capacity.polecats." + rigName
+}
+
+// rigPolecatCapacity reads a rig's polecat cap from town beads config.
+// Returns false if no positive cap is configured.
+func rigPolecatCapacity(townRoot, rigName string) (int, bool) {
+	capacity, err := beads.New(townRoot).GetConfigInt(capacityConfigKey(rigName), 0)
+	if err != nil || capacity <= 0 {
+		return 0, false
+	}
+	return capacity, true
+}
+
+// countRunningPolecats returns how many of the rig's polecats have a live
+// session. An error means the count is unknown, so callers must not treat
+// the rig as under capacity.
+func countRunningPolecats(mgr *polecat.Manager, sessMgr *polecat.SessionManager) (int, error) {
+	polecats, err := mgr.List()
+	if err != nil {
+		return 0, fmt.Errorf("listing polecats: %w", err)
+	}
+	running := 0
+	for _, p := range polecats {
+		ok, err := sessMgr.IsRunning(p.Name)
+		if err != nil {
+			return 0, fmt.Errorf("checking session for %s: %w", p.Name, err)
+		}
+		if ok {
+			running++
+		}
+	}
+	return running, nil
+}
+
+// enforceRigCapacity returns ErrRigAtCapacity if running has reached capacity.
+func enforceRigCapacity(rigName string, capacity, running int) error {
+	if running < capacity {
+		return nil
+	}
+	return fmt.Errorf("%w: %s has %d/%d polecats running\nUse --force to sling anyway, or raise the cap with 'bd config set %s <n>'",
+		ErrRigAtCapacity, rigName, running, capacity, capacityConfigKey(rigName))
+}
+
+// rigSpawnSlots returns how many more polecats a rig may start before
+// reaching its capacity, or -1 if no cap is configured or the rig or its
+// running polecats cannot be loaded (the spawn itself reports that).
+func rigSpawnSlots(townRoot, rigName string) int {
+	capacity, ok := rigPolecatCapacity(townRoot, rigName)
+	if !ok {
+		return -1
+	}
+	rigsConfig, err := config.LoadRigsConfig(filepath.Join(townRoot, "mayor", "rigs.json"))
+	if err != nil {
+		return -1
+	}
+	r, err := rig.NewManager(townRoot, rigsConfig, git.NewGit(townRoot)).GetRig(rigName)
+	if err != nil {
+		return -1
+	}
+	t := tmux.NewTmux()
+	running, err := countRunningPolecats(polecat.NewManager(r, git.NewGit(r.Path), t), polecat.NewSessionManager(t, r))
+	if err != nil {
+		return -1
+	}
+	return max(capacity-running, 0)
+}
+
+// BatchPlan is what a batch sling would do, computed without spawning.
+type BatchPlan struct {
+	Spawn   []string // Beads that would get a polecat
+	Refused []string // Beads refused once the rig reaches capacity
+}
+
+// planBatchSling splits a batch in sling order: the first slots beads get a
+// polecat and the rest are refused with ErrRigAtCapacity, as a real batch
+// sling would do. Negative slots means uncapped.
+func planBatchSling(beadIDs []string, slots int) BatchPlan {
+	if slots < 0 || slots >= len(beadIDs) {
+		return BatchPlan{Spawn: beadIDs}
+	}
+	return BatchPlan{Spawn: beadIDs[:slots], Refused: beadIDs[slots:]}
+}
@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestEnforceRigCapacity(t *testing.T) {
+	const capacity = 3
+
+	// Sling up to the cap, then one beyond it.
+	for running := 0; running <= capacity; running++ {
+		err := enforceRigCapacity("gastown", capacity, running)
+		if running < capacity {
+			if err != nil {
+				t.Fatalf("running=%d: unexpected rejection: %v", running, err)
+			}
+			continue
+		}
+		if !errors.Is(err, ErrRigAtCapacity) {
+			t.Fatalf("running=%d: err = %v, want ErrRigAtCapacity", running, err)
+		}
+		if !strings.Contains(err.Error(), "3/3") {
+			t.Errorf("error should report count and cap: %v", err)
+		}
+		if !strings.Contains(err.Error(), "capacity.polecats.gastown") {
+			t.Errorf("error should name the config key: %v", err)
+		}
+	}
+}
+
+func TestPlanBatchSling(t *testing.T) {
+	beadIDs := []string{"gt-a", "gt-b", "gt-c", "gt-d"}
+	tests := []struct {
+		name    string
+		slots   int
+		spawn   string
+		refused string
+	}{
+		{"uncapped", -1, "gt-a,gt-b,gt-c,gt-d", ""},
+		{"at capacity", 0, "", "gt-a,gt-b,gt-c,gt-d"},
+		{"room for two", 2, "gt-a,gt-b", "gt-c,gt-d"},
+		{"room for exactly all", 4, "gt-a,gt-b,gt-c,gt-d", ""},
+		{"room to spare", 10, "gt-a,gt-b,gt-c,gt-d", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := planBatchSling(beadIDs, tt.slots)
+			if got := strings.Join(plan.Spawn, ","); got != tt.spawn {
+				t.Errorf("Spawn = %q, want %q", got, tt.spawn)
+			}
+			if got := strings.Join(plan.Refused, ","); got != tt.refused {
+				t.Errorf("Refused = %q, want %q", got, tt.refused)
+			}
+		})
+	}
+}
@@ -1,34 +1,34 @@
 package cmd
 
 import (
-	"crypto/rand"
-	"encoding/base32"
 	"fmt"
-	"os"
 	"os/exec"
 	"path/filepath"
 	"strings"
 
 	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/style"
-	"github.com/steveyegge/gastown/internal/workspace"
 )
 
-// slingGenerateShortID generates a short random ID (5 lowercase chars).
-func slingGenerateShortID() string {
-	b := make([]byte, 3)
-	_, _ = rand.Read(b)
-	return strings.ToLower(base32.StdEncoding.EncodeToString(b)[:5])
+// autoConvoyConfigKey is the town beads config key controlling whether sling
+// creates a convoy for untracked beads. Defaults to true when unset.
+const autoConvoyConfigKey = "sling.auto_convoy"
+
+// autoConvoyEnabled reports whether sling should auto-create a convoy.
+// An explicit --no-convoy flag wins (including --no-convoy=false); otherwise
+// the town's sling.auto_convoy setting applies, defaulting to true.
+func autoConvoyEnabled(townRoot string, noConvoy, noConvoyChanged bool) bool {
+	if noConvoy || noConvoyChanged {
+		return !noConvoy
+	}
+	// A malformed value falls back to the default
+	enabled, _ := beads.New(townRoot).GetConfigBool(autoConvoyConfigKey, true)
+	return enabled
 }
 
 // isTrackedByConvoy checks if an issue is already being tracked by a convoy.
 // Returns the convoy ID if tracked, empty string otherwise.
-func isTrackedByConvoy(beadID string) string {
-	townRoot, err := workspace.FindFromCwd()
-	if err != nil {
-		return ""
-	}
-
+func isTrackedByConvoy(townRoot, beadID string) string {
 	// Query town beads for any convoy that tracks this issue
 	// Convoys use "tracks" dependency type: convoy -> tracked issue
 	townBeads := filepath.Join(townRoot, ".beads")
@@ -57,55 +57,19 @@ func isTrackedByConvoy(beadID string) string {
 }
 
 // createAutoConvoy creates an auto-convoy for a single issue and tracks it.
-// Returns the created convoy ID.
-func createAutoConvoy(beadID, beadTitle string) (string, error) {
-	townRoot, err := workspace.FindFromCwd()
-	if err != nil {
-		return "", fmt.Errorf("finding town root: %w", err)
+// The convoy ID is derived from the issue, so concurrent slings of the same
+// issue converge on one convoy; created is false if another sling won.
+func createAutoConvoy(townRoot, beadID, beadTitle string) (convoyID string, created bool, err error) {
+	// Convoys live in town beads with the hq-cv- prefix (registered in routes during gt install)
+	convoyID, created, err = beads.New(townRoot).EnsureAutoConvoy(beadID, beadTitle, formatTrackBeadID(beadID))
+	if err != nil && convoyID == "" {
+		return "", false, err
 	}
-
-	townBeads := filepath.Join(townRoot, ".beads")
-
-	// Generate convoy ID with hq-cv- prefix for visual distinction
-	// The hq-cv- prefix is registered in routes during gt install
-	convoyID := fmt.Sprintf("hq-cv-%s", slingGenerateShortID())
-
-	// Create convoy with title "Work: <issue-title>"
-	convoyTitle := fmt.Sprintf("Work: %s", beadTitle)
-	description := fmt.Sprintf("Auto-created convoy tracking %s", beadID)
-
-	createArgs := []string{
-		"create",
-		"--type=convoy",
-		"--id=" + convoyID,
-		"--title=" + convoyTitle,
-		"--description=" + description,
-	}
-	if beads.NeedsForceForID(convoyID) {
-		createArgs = append(createArgs, "--force")
-	}
-
-	createCmd := exec.Command("bd", append([]string{"--no-daemon"}, createArgs...)...)
-	createCmd.Dir = townBeads
-	createCmd.Stderr = os.Stderr
-
-	if err := createCmd.Run(); err != nil {
-		return "", fmt.Errorf("creating convoy: %w", err)
-	}
-
-	// Add tracking relation: convoy tracks the issue
-	trackBeadID := formatTrackBeadID(beadID)
-	depArgs := []string{"--no-daemon", "dep", "add", convoyID, trackBeadID, "--type=tracks"}
-	depCmd := exec.Command("bd", depArgs...)
-	depCmd.Dir = townBeads
-	depCmd.Stderr = os.Stderr
-
-	if err := depCmd.Run(); err != nil {
+	if err != nil {
 		// Convoy was created but tracking failed - log warning but continue
 		fmt.Printf("%s Could not add tracking relation: %v\n", style.Dim.Render("Warning:"), err)
 	}
-
-	return convoyID, nil
+	return convoyID, created, nil
 }
 
 // formatTrackBeadID formats a bead ID for use in convoy tracking dependencies.
@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// stubAutoConvoyConfig installs a bd stub whose "config get" prints value
+// (or "(not set)" when empty).
+func stubAutoConvoyConfig(t *testing.T, value string) {
+	t.Helper()
+	if value == "" {
+		value = autoConvoyConfigKey + " (not set)"
+	}
+	binDir := t.TempDir()
+	script := "#!/bin/sh\n[ \"$1\" = \"--allow-stale\" ] && shift\n" +
+		"if [ \"$1\" = \"config\" ] && [ \"$2\" = \"get\" ]; then printf '%s\\n' '" + value + "'; fi\nexit 0\n"
+	if err := os.WriteFile(filepath.Join(binDir, "bd"), []byte(script), 0755); err != nil {
+		t.Fatalf("write bd stub: %v", err)
+	}
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+}
+
+func TestAutoConvoyEnabled(t *testing.T) {
+	tests := []struct {
+		name            string
+		config          string
+		noConvoy        bool
+		noConvoyChanged bool
+		want            bool
+	}{
+		{"unset defaults on", "", false, false, true},
+		{"config false, no flag", "false", false, false, false},
+		{"config true, no flag", "true", false, false, true},
+		{"flag overrides config true", "true", true, true, false},
+		{"explicit --no-convoy=false overrides config false", "false", false, true, true},
+		{"invalid config defaults on", "sometimes", false, false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stubAutoConvoyConfig(t, tt.config)
+			if got := autoConvoyEnabled(t.TempDir(), tt.noConvoy, tt.noConvoyChanged); got != tt.want {
+				t.Errorf("autoConvoyEnabled() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
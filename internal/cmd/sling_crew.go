@@ -0,0 +1,89 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/steveyegge/gastown/internal/beads"
+)
+
+// ErrNoIdleCrew is returned when a "<rig>/crew" target has no idle crew
+// member able to take the work.
+var ErrNoIdleCrew = errors.New("no idle crew member")
+
+// crewPoolRig reports whether target addresses a rig's crew as a whole
+// ("<rig>/crew") rather than a specific member, returning the rig name.
+func crewPoolRig(target string) (string, bool) {
+	parts := strings.Split(target, "/")
+	if len(parts) == 2 && parts[0] != "" && parts[1] == "crew" {
+		return parts[0], true
+	}
+	return "", false
+}
+
+// crewPoolBeads is the slice of beads used to pick a crew member.
+type crewPoolBeads interface {
+	IdleAgents(rigName string) ([]*beads.Issue, error)
+	List(opts beads.ListOptions) ([]*beads.Issue, error)
+}
+
+// resolveCrewTarget resolves a "<rig>/crew" target to a crew member using
+// the rig's beads.
+func resolveCrewTarget(townRoot, rigName string) (agentID, pane, hookRoot string, err error) {
+	b := beads.New(filepath.Join(townRoot, rigName))
+	return resolveCrewPool(b, rigName, resolveSessionTarget)
+}
+
+// resolveCrewPool picks the least-loaded idle crew member of a rig: the one
+// with the fewest open beads queued for it, ties broken by bead ID. Members
+// that resolve fails for (no running session) are passed over. Returns
+// ErrNoIdleCrew when no member can take the work.
+func resolveCrewPool(b crewPoolBeads, rigName string, resolve func(target string) (string, string, string, error)) (agentID, pane, hookRoot string, err error) {
+	idle, err := b.IdleAgents(rigName)
+	if err != nil {
+		return "", "", "", fmt.Errorf("listing idle agents in %s: %w", rigName, err)
+	}
+
+	type candidate struct {
+		target string
+		queued int
+	}
+	var candidates []candidate
+	for _, agent := range idle {
+		_, role, name, ok := beads.ParseAgentBeadID(agent.ID)
+		if !ok || role != "crew" || name == "" {
+			continue
+		}
+		target := fmt.Sprintf("%s/crew/%s", rigName, name)
+		queued, err := b.List(beads.ListOptions{Status: "open", Assignee: target, Priority: -1})
+		if err != nil {
+			return "", "", "", fmt.Errorf("checking queue for %s: %w", target, err)
+		}
+		candidates = append(candidates, candidate{target: target, queued: len(queued)})
+	}
+	// Stable: IdleAgents' ID order breaks ties
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].queued < candidates[j].queued })
+
+	for _, c := range candidates {
+		if agentID, pane, hookRoot, err := resolve(c.target); err == nil {
+			return agentID, pane, hookRoot, nil
+		}
+	}
+	return "", "", "", fmt.Errorf("%w in rig %s", ErrNoIdleCrew, rigName)
+}
+
+// slingSpawnFallback decides whether a target that failed to resolve should
+// get a fresh polecat instead: a dead polecat (no active session) or a crew
+// pool with nobody idle. Returns the rig to spawn in and why, or "" to fail.
+func slingSpawnFallback(target string, err error) (rigName, reason string) {
+	if rig, ok := crewPoolRig(target); ok && errors.Is(err, ErrNoIdleCrew) {
+		return rig, "No idle crew member"
+	}
+	if isPolecatTarget(target) {
+		return strings.Split(target, "/")[0], "Target polecat has no active session"
+	}
+	return "", ""
+}
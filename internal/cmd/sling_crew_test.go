@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/beads"
+)
+
+// fakeCrewPool serves idle agents and per-assignee open bead counts.
+type fakeCrewPool struct {
+	idle   []*beads.Issue
+	queued map[string]int
+}
+
+func (f *fakeCrewPool) IdleAgents(rigName string) ([]*beads.Issue, error) {
+	return f.idle, nil
+}
+
+func (f *fakeCrewPool) List(opts beads.ListOptions) ([]*beads.Issue, error) {
+	return make([]*beads.Issue, f.queued[opts.Assignee]), nil
+}
+
+// liveSessions resolves only the given targets, as if only they had a
+// running tmux session.
+func liveSessions(live ...string) func(string) (string, string, string, error) {
+	return func(target string) (string, string, string, error) {
+		for _, l := range live {
+			if l == target {
+				return target, "%1", "/town/" + target, nil
+			}
+		}
+		return "", "", "", fmt.Errorf("no session for %s", target)
+	}
+}
+
+func TestResolveCrewPoolPicksLeastLoaded(t *testing.T) {
+	pool := &fakeCrewPool{
+		idle: []*beads.Issue{
+			{ID: "gt-gastown-crew-amy"},
+			{ID: "gt-gastown-crew-max"},
+			{ID: "gt-gastown-crew-zed"},
+			{ID: "gt-gastown-polecat-ace"},
+		},
+		queued: map[string]int{"gastown/crew/amy": 2, "gastown/crew/max": 1},
+	}
+
+	// zed has nothing queued but no session; max is next least loaded
+	agentID, _, _, err := resolveCrewPool(pool, "gastown",
+		liveSessions("gastown/crew/amy", "gastown/crew/max", "gastown/polecats/ace"))
+	if err != nil {
+		t.Fatalf("resolveCrewPool: %v", err)
+	}
+	if agentID != "gastown/crew/max" {
+		t.Errorf("agentID = %q, want gastown/crew/max", agentID)
+	}
+}
+
+func TestResolveCrewPoolAllBusySpawnsPolecat(t *testing.T) {
+	// Busy crew members are not idle, so only the polecat shows up
+	pool := &fakeCrewPool{idle: []*beads.Issue{{ID: "gt-gastown-polecat-ace"}}}
+
+	_, _, _, err := resolveCrewPool(pool, "gastown", liveSessions("gastown/polecats/ace"))
+	if !errors.Is(err, ErrNoIdleCrew) {
+		t.Fatalf("err = %v, want ErrNoIdleCrew", err)
+	}
+
+	rigName, reason := slingSpawnFallback("gastown/crew", err)
+	if rigName != "gastown" || reason == "" {
+		t.Errorf("slingSpawnFallback = (%q, %q), want spawn in gastown", rigName, reason)
+	}
+}
+
+func TestSlingSpawnFallback(t *testing.T) {
+	otherErr := errors.New("session not found")
+	tests := []struct {
+		target string
+		err    error
+		want   string
+	}{
+		{"gastown/polecats/Toast", otherErr, "gastown"},
+		{"gastown/crew", otherErr, ""},
+		{"gastown/crew/max", otherErr, ""},
+		{"mayor", otherErr, ""},
+	}
+	for _, tt := range tests {
+		if got, _ := slingSpawnFallback(tt.target, tt.err); got != tt.want {
+			t.Errorf("slingSpawnFallback(%q) rig = %q, want %q", tt.target, got, tt.want)
+		}
+	}
+}
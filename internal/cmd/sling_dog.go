@@ -3,6 +3,7 @@ package cmd
 import (
 	"fmt"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/steveyegge/gastown/internal/config"
@@ -43,15 +44,11 @@ type DogDispatchInfo struct {
 	Spawned bool   // True if dog was spawned (new)
 }
 
-// DispatchToDog finds or spawns a dog for work dispatch.
+// DispatchToDog finds or spawns a dog for work dispatch and records work
+// (a bead ID or formula name) as its assignment.
 // If dogName is empty, finds an idle dog from the pool.
 // If create is true and no dogs exist, creates one.
-func DispatchToDog(dogName string, create bool) (*DogDispatchInfo, error) {
-	townRoot, err := workspace.FindFromCwd()
-	if err != nil {
-		return nil, fmt.Errorf("finding town root: %w", err)
-	}
-
+func DispatchToDog(townRoot, dogName, work string, create bool) (*DogDispatchInfo, error) {
 	rigsConfigPath := filepath.Join(townRoot, "mayor", "rigs.json")
 	rigsConfig, err := config.LoadRigsConfig(rigsConfigPath)
 	if err != nil {
@@ -60,6 +57,14 @@ func DispatchToDog(dogName string, create bool) (*DogDispatchInfo, error) {
 
 	mgr := dog.NewManager(townRoot, rigsConfig)
 
+	// Hold the dispatch lock from picking the dog until it is marked
+	// working, so autoscaling cannot remove it in between
+	unlock, err := mgr.LockDispatch()
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
 	var targetDog *dog.Dog
 	var spawned bool
 
@@ -102,18 +107,16 @@ func DispatchToDog(dogName string, create bool) (*DogDispatchInfo, error) {
 		}
 	}
 
-	// Mark dog as working
-	if err := mgr.SetState(targetDog.Name, dog.StateWorking); err != nil {
-		return nil, fmt.Errorf("setting dog state: %w", err)
+	// Mark dog as working on this assignment
+	if err := mgr.AssignWork(targetDog.Name, work); err != nil {
+		return nil, fmt.Errorf("assigning work to dog: %w", err)
 	}
 
 	// Build agent ID
 	agentID := fmt.Sprintf("deacon/dogs/%s", targetDog.Name)
 
 	// Try to find tmux session for the dog (dogs may run in tmux like polecats)
-	// Dogs use the pattern gt-{town}-deacon-{name}
-	townName, _ := workspace.GetTownName(townRoot)
-	sessionName := fmt.Sprintf("gt-%s-deacon-%s", townName, targetDog.Name)
+	sessionName := dogSessionName(townRoot, targetDog.Name)
 	t := tmux.NewTmux()
 	var pane string
 	if has, _ := t.HasSession(sessionName); has {
@@ -129,30 +132,96 @@ func DispatchToDog(dogName string, create bool) (*DogDispatchInfo, error) {
 	}, nil
 }
 
-// generateDogName creates a unique dog name for pool expansion.
-func generateDogName(mgr *dog.Manager) string {
-	// Use Greek alphabet for dog names
-	names := []string{"alpha", "bravo", "charlie", "delta", "echo", "foxtrot", "golf", "hotel"}
+// dogSessionName returns a dog's tmux session name, gt-{town}-deacon-{name}.
+func dogSessionName(townRoot, dogName string) string {
+	townName, _ := workspace.GetTownName(townRoot)
+	return fmt.Sprintf("gt-%s-deacon-%s", townName, dogName)
+}
 
-	dogs, _ := mgr.List()
-	existing := make(map[string]bool)
-	for _, d := range dogs {
-		existing[d.Name] = true
+// cancelDogDispatch releases a working dog's bead and idles the dog,
+// returning the work that was cancelled. Non-bead work (plugins, formulas)
+// has nothing to release. The dog stays working if the release fails, so
+// the cancel can be retried. The dog's session is left alone; see
+// interruptDog.
+func cancelDogDispatch(townRoot string, mgr *dog.Manager, dogName string) (string, error) {
+	unlock, err := mgr.LockDispatch()
+	if err != nil {
+		return "", err
 	}
+	defer unlock()
 
-	for _, name := range names {
-		if !existing[name] {
-			return name
-		}
+	d, err := mgr.Get(dogName)
+	if err != nil {
+		return "", fmt.Errorf("getting dog %s: %w", dogName, err)
+	}
+	if d.State != dog.StateWorking {
+		return "", fmt.Errorf("dog %s is idle: no dispatch to cancel", dogName)
 	}
 
-	// Fallback: numbered dogs
-	for i := 1; i <= 100; i++ {
-		name := fmt.Sprintf("dog%d", i)
-		if !existing[name] {
-			return name
+	if looksLikeBeadID(d.Work) {
+		if err := beadsForBead(townRoot, d.Work, "").ReleaseWithReason(d.Work, "dog dispatch cancelled"); err != nil {
+			return "", fmt.Errorf("releasing %s: %w", d.Work, err)
 		}
 	}
+	if err := mgr.ClearWork(dogName); err != nil {
+		return d.Work, fmt.Errorf("idling dog %s: %w", dogName, err)
+	}
+	return d.Work, nil
+}
+
+// interruptDog stops a dog's session from carrying on with cancelled work:
+// it interrupts the agent (Escape) and tells it the dispatch was taken back.
+// Returns false if the dog has no running session.
+func interruptDog(t *tmux.Tmux, townRoot, dogName, work string) (bool, error) {
+	sessionName := dogSessionName(townRoot, dogName)
+	if has, err := t.HasSession(sessionName); err != nil || !has {
+		return false, err
+	}
+	if err := t.SendKeysRaw(sessionName, "Escape"); err != nil {
+		return false, fmt.Errorf("interrupting %s: %w", sessionName, err)
+	}
+	msg := fmt.Sprintf("[CANCELLED] Your dispatch of %s was cancelled. Stop work on it and wait for new work.", work)
+	if err := t.NudgeSession(sessionName, msg); err != nil {
+		return true, fmt.Errorf("notifying %s: %w", sessionName, err)
+	}
+	return true, nil
+}
+
+// DogStatus is one dog's entry in the kennel.
+type DogStatus struct {
+	Name  string    // Dog name (e.g., "alpha")
+	State dog.State // Idle or working
+	Work  string    // Current assignment (bead ID or formula), empty when idle
+}
+
+// DogPoolStatus returns the state of every dog in the town's kennel, sorted
+// by name. Dogs persist their state in .dog.json, so this reflects dispatches
+// made by any gt process.
+func DogPoolStatus() ([]DogStatus, error) {
+	mgr, err := getDogManager()
+	if err != nil {
+		return nil, err
+	}
+	return dogPoolStatus(mgr)
+}
+
+// dogPoolStatus builds the kennel status from a dog manager.
+func dogPoolStatus(mgr *dog.Manager) ([]DogStatus, error) {
+	dogs, err := mgr.List()
+	if err != nil {
+		return nil, err
+	}
+
+	pool := make([]DogStatus, 0, len(dogs))
+	for _, d := range dogs {
+		pool = append(pool, DogStatus{Name: d.Name, State: d.State, Work: d.Work})
+	}
+	sort.Slice(pool, func(i, j int) bool { return pool[i].Name < pool[j].Name })
+	return pool, nil
+}
 
-	return fmt.Sprintf("dog%d", len(dogs)+1)
+// generateDogName creates a unique dog name for pool expansion.
+func generateDogName(mgr *dog.Manager) string {
+	dogs, _ := mgr.List()
+	return dog.NewDogName(dogs)
 }
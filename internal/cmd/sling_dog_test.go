@@ -0,0 +1,167 @@
+package cmd
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/dog"
+	"github.com/steveyegge/gastown/internal/tmux"
+)
+
+// setupKennel writes idle dogs into townRoot's kennel without worktrees.
+func setupKennel(t *testing.T, townRoot string, names ...string) *dog.Manager {
+	t.Helper()
+	for _, name := range names {
+		dogDir := filepath.Join(townRoot, "deacon", "dogs", name)
+		if err := os.MkdirAll(dogDir, 0755); err != nil {
+			t.Fatalf("mkdir %s: %v", name, err)
+		}
+		data, err := json.Marshal(dog.DogState{Name: name, State: dog.StateIdle})
+		if err != nil {
+			t.Fatalf("marshal state: %v", err)
+		}
+		if err := os.WriteFile(filepath.Join(dogDir, ".dog.json"), data, 0644); err != nil {
+			t.Fatalf("write state: %v", err)
+		}
+	}
+	return dog.NewManager(townRoot, &config.RigsConfig{Rigs: map[string]config.RigEntry{}})
+}
+
+func TestDogPoolStatusReflectsDispatch(t *testing.T) {
+	townRoot := t.TempDir()
+	mgr := setupKennel(t, townRoot, "charlie", "alpha", "bravo")
+
+	// Dispatch records the assignment the same way DispatchToDog does.
+	if err := mgr.AssignWork("bravo", "gt-abc"); err != nil {
+		t.Fatalf("AssignWork: %v", err)
+	}
+
+	pool, err := dogPoolStatus(mgr)
+	if err != nil {
+		t.Fatalf("dogPoolStatus: %v", err)
+	}
+	want := []DogStatus{
+		{Name: "alpha", State: dog.StateIdle},
+		{Name: "bravo", State: dog.StateWorking, Work: "gt-abc"},
+		{Name: "charlie", State: dog.StateIdle},
+	}
+	if len(pool) != len(want) {
+		t.Fatalf("pool = %+v, want %+v", pool, want)
+	}
+	for i := range want {
+		if pool[i] != want[i] {
+			t.Errorf("pool[%d] = %+v, want %+v", i, pool[i], want[i])
+		}
+	}
+
+	if err := mgr.ClearWork("bravo"); err != nil {
+		t.Fatalf("ClearWork: %v", err)
+	}
+	pool, err = dogPoolStatus(mgr)
+	if err != nil {
+		t.Fatalf("dogPoolStatus: %v", err)
+	}
+	if pool[1].State != dog.StateIdle || pool[1].Work != "" {
+		t.Errorf("after ClearWork pool[1] = %+v, want idle with no work", pool[1])
+	}
+}
+
+func TestCancelDogDispatchReleasesBead(t *testing.T) {
+	townRoot := t.TempDir()
+	mgr := setupKennel(t, townRoot, "alpha")
+
+	binDir := t.TempDir()
+	logPath := filepath.Join(binDir, "bd.log")
+	script := "#!/bin/sh\necho \"$*\" >> " + logPath + "\n"
+	if err := os.WriteFile(filepath.Join(binDir, "bd"), []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	if err := mgr.AssignWork("alpha", "gt-abc"); err != nil {
+		t.Fatalf("AssignWork: %v", err)
+	}
+
+	work, err := cancelDogDispatch(townRoot, mgr, "alpha")
+	if err != nil {
+		t.Fatalf("cancelDogDispatch: %v", err)
+	}
+	if work != "gt-abc" {
+		t.Errorf("cancelled work = %q, want gt-abc", work)
+	}
+
+	data, err := os.ReadFile(logPath)
+	if err != nil {
+		t.Fatalf("reading bd log: %v", err)
+	}
+	if !strings.Contains(string(data), "update gt-abc --status=open --assignee=") {
+		t.Errorf("bead not released to open; bd calls:\n%s", data)
+	}
+
+	d, err := mgr.Get("alpha")
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if d.State != dog.StateIdle || d.Work != "" {
+		t.Errorf("dog after cancel = %s/%q, want idle with no work", d.State, d.Work)
+	}
+
+	if _, err := cancelDogDispatch(townRoot, mgr, "alpha"); err == nil {
+		t.Error("expected error cancelling an idle dog")
+	}
+}
+
+func TestCancelDogDispatchPluginWork(t *testing.T) {
+	townRoot := t.TempDir()
+	mgr := setupKennel(t, townRoot, "alpha")
+	// No bd on PATH: plugin work has no bead to release
+	t.Setenv("PATH", t.TempDir())
+
+	if err := mgr.AssignWork("alpha", "plugin:rebuild-gt"); err != nil {
+		t.Fatalf("AssignWork: %v", err)
+	}
+	if _, err := cancelDogDispatch(townRoot, mgr, "alpha"); err != nil {
+		t.Fatalf("cancelDogDispatch: %v", err)
+	}
+	if d, _ := mgr.Get("alpha"); d == nil || d.State != dog.StateIdle {
+		t.Errorf("dog after cancel = %+v, want idle", d)
+	}
+}
+
+func TestInterruptDog(t *testing.T) {
+	binDir := t.TempDir()
+	logPath := filepath.Join(binDir, "tmux.log")
+	// Only alpha has a session
+	script := "#!/bin/sh\necho \"$*\" >> " + logPath + "\n" +
+		"case \"$*\" in *has-session*alpha*) exit 0 ;; *has-session*) echo \"can't find session\" >&2; exit 1 ;; esac\n"
+	if err := os.WriteFile(filepath.Join(binDir, "tmux"), []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+	townRoot := t.TempDir()
+
+	interrupted, err := interruptDog(tmux.NewTmux(), townRoot, "bravo", "gt-abc")
+	if err != nil || interrupted {
+		t.Errorf("interruptDog(bravo) = %v, %v; want no session", interrupted, err)
+	}
+
+	interrupted, err = interruptDog(tmux.NewTmux(), townRoot, "alpha", "gt-abc")
+	if err != nil || !interrupted {
+		t.Fatalf("interruptDog(alpha) = %v, %v; want interrupted", interrupted, err)
+	}
+	data, err := os.ReadFile(logPath)
+	if err != nil {
+		t.Fatalf("reading tmux log: %v", err)
+	}
+	log := string(data)
+	if !strings.Contains(log, "send-keys -t gt--deacon-alpha Escape") {
+		t.Errorf("dog session not interrupted; tmux calls:\n%s", log)
+	}
+	if !strings.Contains(log, "dispatch of gt-abc was cancelled") {
+		t.Errorf("dog not told of the cancel; tmux calls:\n%s", log)
+	}
+}
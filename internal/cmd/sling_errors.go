@@ -0,0 +1,95 @@
+package cmd
+
+import (
+	"errors"
+
+	"github.com/steveyegge/gastown/internal/rig"
+)
+
+// SlingErrorKind says who can fix a failed sling.
+type SlingErrorKind int
+
+const (
+	// SlingSystemError is a failure in gt or its environment (bd, tmux, git).
+	SlingSystemError SlingErrorKind = iota
+	// SlingUserError means the request cannot succeed as given: a bad
+	// argument, a missing bead, or work that needs --force.
+	SlingUserError
+	// SlingTransient may succeed if retried later without changes, e.g. a
+	// rig at capacity or a crew with nobody idle.
+	SlingTransient
+)
+
+func (k SlingErrorKind) String() string {
+	switch k {
+	case SlingUserError:
+		return "user"
+	case SlingTransient:
+		return "transient"
+	default:
+		return "system"
+	}
+}
+
+// SlingError is a sling failure tagged with its kind, so the CLI can choose
+// an exit code (2 for user errors, 1 otherwise) and callers can decide
+// whether retrying is worthwhile.
+type SlingError struct {
+	Kind SlingErrorKind
+	Err  error
+}
+
+func (e *SlingError) Error() string { return e.Err.Error() }
+
+func (e *SlingError) Unwrap() error { return e.Err }
+
+// slingUserError tags err as a user error.
+func slingUserError(err error) error {
+	return &SlingError{Kind: SlingUserError, Err: err}
+}
+
+// SlingErrorKindOf returns the kind of a sling failure. Errors not tagged
+// with a SlingError are classified by the sentinels they wrap, defaulting
+// to SlingSystemError. beads.ErrNotFound is not among them: a missing bead
+// named by the user is tagged where it is looked up (verifyBeadExists), and
+// any other lookup failing is gt's problem.
+func SlingErrorKindOf(err error) SlingErrorKind {
+	var se *SlingError
+	if errors.As(err, &se) {
+		return se.Kind
+	}
+	switch {
+	case errors.Is(err, ErrBeadClaimed),
+		errors.Is(err, ErrTargetBusy),
+		errors.Is(err, ErrBudgetExceeded),
+		errors.Is(err, rig.ErrRigNotFound):
+		return SlingUserError
+	case errors.Is(err, ErrRigAtCapacity),
+		errors.Is(err, ErrNoIdleCrew):
+		return SlingTransient
+	default:
+		return SlingSystemError
+	}
+}
+
+// classifySlingError tags a sling failure with its kind. nil stays nil.
+func classifySlingError(err error) error {
+	if err == nil {
+		return nil
+	}
+	var se *SlingError
+	if errors.As(err, &se) {
+		return err
+	}
+	return &SlingError{Kind: SlingErrorKindOf(err), Err: err}
+}
+
+// slingExitCode returns the process exit code for a failed command: 2 for
+// sling user errors, 1 for everything else.
+func slingExitCode(err error) int {
+	var se *SlingError
+	if errors.As(err, &se) && se.Kind == SlingUserError {
+		return 2
+	}
+	return 1
+}
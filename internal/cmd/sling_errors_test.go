@@ -0,0 +1,95 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/rig"
+)
+
+func TestSlingErrorKinds(t *testing.T) {
+	// A bd that knows no beads
+	binDir := t.TempDir()
+	script := "#!/bin/sh\necho 'Error: Issue not found' >&2\nexit 1\n"
+	if err := os.WriteFile(filepath.Join(binDir, "bd"), []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	_, onExistingErr := parseOnExistingPolicy("sometimes")
+	_, ambiguousErr := slingTargetIsRig("gastown", true, true, false, false)
+	polecatErr := func() error {
+		t.Setenv("GT_POLECAT", "Toast")
+		return runSling(nil, []string{"gt-abc"})
+	}()
+
+	tests := []struct {
+		name string
+		err  error
+		want SlingErrorKind
+	}{
+		{"polecat slinging", polecatErr, SlingUserError},
+		{"bead not found", verifyBeadExists(t.TempDir(), "gt-missing"), SlingUserError},
+		{"bad --on-existing", onExistingErr, SlingUserError},
+		{"rig/bead ambiguity", ambiguousErr, SlingUserError},
+		{"bead claimed", fmt.Errorf("hooking bead: %w", ErrBeadClaimed), SlingUserError},
+		{"target busy", fmt.Errorf("%w: gastown/polecats/Toast", ErrTargetBusy), SlingUserError},
+		{"rig not found", fmt.Errorf("spawning polecat: %w", rig.ErrRigNotFound), SlingUserError},
+		{"over budget", fmt.Errorf("spawning polecat: %w", ErrBudgetExceeded), SlingUserError},
+		{"rig at capacity", fmt.Errorf("spawning polecat: %w", ErrRigAtCapacity), SlingTransient},
+		{"no idle crew", fmt.Errorf("%w in rig gastown", ErrNoIdleCrew), SlingTransient},
+		{"agent bead lookup", fmt.Errorf("reading agent bead: %w", beads.ErrNotFound), SlingSystemError},
+		{"tmux unavailable", errors.New("starting session: tmux: no server running"), SlingSystemError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.err == nil {
+				t.Fatal("expected an error")
+			}
+			if got := SlingErrorKindOf(tt.err); got != tt.want {
+				t.Errorf("SlingErrorKindOf(%v) = %s, want %s", tt.err, got, tt.want)
+			}
+			wantCode := 1
+			if tt.want == SlingUserError {
+				wantCode = 2
+			}
+			if got := slingExitCode(classifySlingError(tt.err)); got != wantCode {
+				t.Errorf("exit code = %d, want %d", got, wantCode)
+			}
+		})
+	}
+}
+
+func TestVerifyBeadExistsBDFailureIsSystemError(t *testing.T) {
+	binDir := t.TempDir()
+	script := "#!/bin/sh\necho 'Error: database is locked' >&2\nexit 1\n"
+	if err := os.WriteFile(filepath.Join(binDir, "bd"), []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	err := verifyBeadExists(t.TempDir(), "gt-abc")
+	if err == nil {
+		t.Fatal("verifyBeadExists() = nil, want the bd failure")
+	}
+	if got := SlingErrorKindOf(err); got != SlingSystemError {
+		t.Errorf("SlingErrorKindOf(%v) = %s, want system", err, got)
+	}
+}
+
+func TestClassifySlingErrorKeepsMessage(t *testing.T) {
+	err := classifySlingError(fmt.Errorf("spawning polecat: %w", ErrRigAtCapacity))
+	if err.Error() != "spawning polecat: rig at polecat capacity" {
+		t.Errorf("message = %q", err)
+	}
+	if !errors.Is(err, ErrRigAtCapacity) {
+		t.Error("classified error no longer wraps its cause")
+	}
+	if classifySlingError(nil) != nil {
+		t.Error("classifySlingError(nil) != nil")
+	}
+}
@@ -0,0 +1,104 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/steveyegge/gastown/internal/beads"
+)
+
+// OnExistingPolicy controls what gt sling does when the target polecat
+// already has a hooked bead.
+type OnExistingPolicy string
+
+const (
+	// OnExistingReject fails the sling and leaves the current hook untouched.
+	OnExistingReject OnExistingPolicy = "reject"
+	// OnExistingQueue assigns the bead to the polecat without hooking it,
+	// so it is picked up after the current work completes (see
+	// nextQueued, run by gt done).
+	OnExistingQueue OnExistingPolicy = "queue"
+	// OnExistingReplace hooks the new bead in place of the current one,
+	// releasing the current bead back to open once the new hook succeeds.
+	OnExistingReplace OnExistingPolicy = "replace"
+)
+
+// ErrTargetBusy is returned when the target polecat already has hooked work
+// and the OnExisting policy is reject.
+var ErrTargetBusy = errors.New("target already has hooked work")
+
+// parseOnExistingPolicy validates a --on-existing flag value.
+// An empty value selects the default, OnExistingReject.
+func parseOnExistingPolicy(s string) (OnExistingPolicy, error) {
+	switch p := OnExistingPolicy(strings.ToLower(strings.TrimSpace(s))); p {
+	case "":
+		return OnExistingReject, nil
+	case OnExistingReject, OnExistingQueue, OnExistingReplace:
+		return p, nil
+	default:
+		return "", slingUserError(fmt.Errorf("invalid --on-existing %q (want reject, queue, or replace)", s))
+	}
+}
+
+// applyOnExisting enforces policy for slinging beadID to targetAgent.
+// It returns queued=true when the bead was enqueued behind the agent's current
+// work, in which case the caller must not hook it. Under OnExistingReplace it
+// returns the beads to displace; the caller releases them with releaseReplaced
+// once the new bead is hooked, so a failed hook leaves the agent's work intact.
+// Re-slinging the bead that is already hooked is not treated as a conflict.
+func applyOnExisting(b *beads.Beads, policy OnExistingPolicy, targetAgent, beadID string) (queued bool, replace []string, err error) {
+	hooked, err := b.List(beads.ListOptions{Status: beads.StatusHooked, Assignee: targetAgent, Priority: -1})
+	if err != nil {
+		return false, nil, fmt.Errorf("checking hook for %s: %w", targetAgent, err)
+	}
+
+	var current []string
+	for _, issue := range hooked {
+		if issue.ID != beadID {
+			current = append(current, issue.ID)
+		}
+	}
+	if len(current) == 0 {
+		return false, nil, nil
+	}
+
+	switch policy {
+	case OnExistingQueue:
+		status := "open"
+		if err := b.Update(beadID, beads.UpdateOptions{Status: &status, Assignee: &targetAgent}); err != nil {
+			return false, nil, fmt.Errorf("queueing %s for %s: %w", beadID, targetAgent, err)
+		}
+		return true, nil, nil
+	case OnExistingReplace:
+		return false, current, nil
+	default:
+		return false, nil, fmt.Errorf("%w: %s has %s hooked\nUse --on-existing=queue or --on-existing=replace",
+			ErrTargetBusy, targetAgent, strings.Join(current, ", "))
+	}
+}
+
+// releaseReplaced releases the beads displaced by beadID back to open.
+func releaseReplaced(b *beads.Beads, replace []string, beadID string) error {
+	for _, id := range replace {
+		if err := b.ReleaseWithReason(id, "replaced by "+beadID); err != nil {
+			return fmt.Errorf("releasing %s: %w", id, err)
+		}
+	}
+	return nil
+}
+
+// nextQueued returns the next bead queued for agent: the open bead assigned
+// to it with the highest priority, or "" if nothing is queued.
+func nextQueued(b *beads.Beads, agent string) (string, error) {
+	queued, err := b.List(beads.ListOptions{Status: "open", Assignee: agent, Priority: -1})
+	if err != nil {
+		return "", fmt.Errorf("listing work queued for %s: %w", agent, err)
+	}
+	if len(queued) == 0 {
+		return "", nil
+	}
+	sort.SliceStable(queued, func(i, j int) bool { return queued[i].Priority < queued[j].Priority })
+	return queued[0].ID, nil
+}
@@ -0,0 +1,235 @@
+package cmd
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/git"
+)
+
+// installHookStateStub installs a bd stub that keeps one "status assignee"
+// file per bead under stateDir, supporting just enough of list and update
+// for applyOnExisting.
+func installHookStateStub(t *testing.T) (stateDir string) {
+	t.Helper()
+	dir := t.TempDir()
+	stateDir = filepath.Join(dir, "state")
+	binDir := filepath.Join(dir, "bin")
+	for _, d := range []string{stateDir, binDir} {
+		if err := os.MkdirAll(d, 0755); err != nil {
+			t.Fatalf("mkdir %s: %v", d, err)
+		}
+	}
+
+	script := `#!/bin/sh
+STATE="` + stateDir + `"
+[ "$1" = "--allow-stale" ] && shift
+cmd="$1"; shift
+case "$cmd" in
+  list)
+    want_status=""; want_assignee=""
+    for arg in "$@"; do
+      case "$arg" in
+        --status=*) want_status="${arg#--status=}" ;;
+        --assignee=*) want_assignee="${arg#--assignee=}" ;;
+      esac
+    done
+    out="["; sep=""
+    for f in "$STATE"/*; do
+      [ -f "$f" ] || continue
+      read -r status assignee < "$f"
+      [ -n "$want_status" ] && [ "$status" != "$want_status" ] && continue
+      [ -n "$want_assignee" ] && [ "$assignee" != "$want_assignee" ] && continue
+      out="$out$sep{\"id\":\"$(basename "$f")\",\"status\":\"$status\",\"assignee\":\"$assignee\"}"
+      sep=","
+    done
+    printf '%s\n' "$out]"
+    ;;
+  update)
+    id="$1"; shift
+    read -r status assignee < "$STATE/$id"
+    for arg in "$@"; do
+      case "$arg" in
+        --status=*) status="${arg#--status=}" ;;
+        --assignee=*) assignee="${arg#--assignee=}" ;;
+      esac
+    done
+    printf '%s %s\n' "$status" "$assignee" > "$STATE/$id"
+    ;;
+esac
+exit 0
+`
+	if err := os.WriteFile(filepath.Join(binDir, "bd"), []byte(script), 0755); err != nil {
+		t.Fatalf("write bd stub: %v", err)
+	}
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+	return stateDir
+}
+
+func writeHookState(t *testing.T, stateDir, id, status, assignee string) {
+	t.Helper()
+	if err := os.WriteFile(filepath.Join(stateDir, id), []byte(status+" "+assignee+"\n"), 0644); err != nil {
+		t.Fatalf("write state for %s: %v", id, err)
+	}
+}
+
+func readHookState(t *testing.T, stateDir, id string) string {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join(stateDir, id))
+	if err != nil {
+		t.Fatalf("read state for %s: %v", id, err)
+	}
+	return strings.TrimSpace(string(data))
+}
+
+func TestApplyOnExisting(t *testing.T) {
+	const agent = "gastown/polecats/Toast"
+
+	tests := []struct {
+		name        string
+		policy      OnExistingPolicy
+		wantErr     error
+		wantQueued  bool
+		wantReplace string // beads returned for the caller to release
+		wantOld     string // final "status assignee" of the current hooked bead
+		wantNew     string // final "status assignee" of the slung bead
+	}{
+		{"reject", OnExistingReject, ErrTargetBusy, false, "", "hooked " + agent, "open"},
+		{"queue", OnExistingQueue, nil, true, "", "hooked " + agent, "open " + agent},
+		// replace leaves the current bead hooked until the new hook succeeds
+		{"replace", OnExistingReplace, nil, false, "gt-old", "hooked " + agent, "open"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stateDir := installHookStateStub(t)
+			writeHookState(t, stateDir, "gt-old", "hooked", agent)
+			writeHookState(t, stateDir, "gt-new", "open", "")
+
+			b := beads.New(t.TempDir())
+			queued, replace, err := applyOnExisting(b, tt.policy, agent, "gt-new")
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("applyOnExisting() error = %v, want %v", err, tt.wantErr)
+			}
+			if queued != tt.wantQueued {
+				t.Errorf("queued = %v, want %v", queued, tt.wantQueued)
+			}
+			if got := strings.Join(replace, ","); got != tt.wantReplace {
+				t.Errorf("replace = %q, want %q", got, tt.wantReplace)
+			}
+			if got := readHookState(t, stateDir, "gt-old"); got != tt.wantOld {
+				t.Errorf("gt-old state = %q, want %q", got, tt.wantOld)
+			}
+			if got := readHookState(t, stateDir, "gt-new"); got != tt.wantNew {
+				t.Errorf("gt-new state = %q, want %q", got, tt.wantNew)
+			}
+		})
+	}
+}
+
+func TestReleaseReplaced(t *testing.T) {
+	const agent = "gastown/polecats/Toast"
+	stateDir := installHookStateStub(t)
+	writeHookState(t, stateDir, "gt-old", "hooked", agent)
+
+	if err := releaseReplaced(beads.New(t.TempDir()), []string{"gt-old"}, "gt-new"); err != nil {
+		t.Fatalf("releaseReplaced: %v", err)
+	}
+	if got := readHookState(t, stateDir, "gt-old"); got != "open" {
+		t.Errorf("gt-old state = %q, want open", got)
+	}
+}
+
+func TestApplyOnExistingIdleOrSameBead(t *testing.T) {
+	const agent = "gastown/polecats/Toast"
+	stateDir := installHookStateStub(t)
+	writeHookState(t, stateDir, "gt-new", "hooked", agent)
+
+	// Re-slinging the already-hooked bead is not a conflict, even under reject.
+	queued, replace, err := applyOnExisting(beads.New(t.TempDir()), OnExistingReject, agent, "gt-new")
+	if err != nil || queued || len(replace) != 0 {
+		t.Fatalf("applyOnExisting() = (%v, %v, %v), want (false, nil, nil)", queued, replace, err)
+	}
+}
+
+func TestNextQueued(t *testing.T) {
+	const agent = "gastown/polecats/Toast"
+	stateDir := installHookStateStub(t)
+	writeHookState(t, stateDir, "gt-old", "hooked", agent)
+	writeHookState(t, stateDir, "gt-new", "open", "")
+	b := beads.New(t.TempDir())
+
+	if queued, _, err := applyOnExisting(b, OnExistingQueue, agent, "gt-new"); err != nil || !queued {
+		t.Fatalf("applyOnExisting() = (%v, %v), want (true, nil)", queued, err)
+	}
+	if next, err := nextQueued(b, "gastown/polecats/Nux"); err != nil || next != "" {
+		t.Fatalf("nextQueued(other agent) = (%q, %v), want nothing queued", next, err)
+	}
+	if next, err := nextQueued(b, agent); err != nil || next != "gt-new" {
+		t.Fatalf("nextQueued() = (%q, %v), want gt-new", next, err)
+	}
+}
+
+func TestPickUpQueuedWork(t *testing.T) {
+	const agent = "gastown/polecats/Toast"
+	stateDir := installHookStateStub(t)
+	writeHookState(t, stateDir, "gt-new", "open", agent)
+	t.Setenv(EnvGTRole, agent)
+
+	// A polecat worktree cloned from origin, on the finished bead's branch
+	root := t.TempDir()
+	origin := filepath.Join(root, "origin.git")
+	townRoot := filepath.Join(root, "town")
+	worktree := filepath.Join(townRoot, "gastown", "polecats", "Toast", "gastown")
+	for _, args := range [][]string{
+		{"init", "--bare", "--initial-branch=main", origin},
+		{"clone", origin, worktree},
+		{"-C", worktree, "-c", "user.email=t@t", "-c", "user.name=t", "commit", "--allow-empty", "-m", "init"},
+		{"-C", worktree, "push", "origin", "HEAD:main"},
+		{"-C", worktree, "checkout", "-b", "polecat/Toast/gt-old@1"},
+	} {
+		if out, err := exec.Command("git", args...).CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+	}
+
+	g := git.NewGit(worktree)
+	if next := pickUpQueuedWork(worktree, townRoot, g, "main"); next != "gt-new" {
+		t.Fatalf("pickUpQueuedWork() = %q, want gt-new", next)
+	}
+	branch, err := g.CurrentBranch()
+	if err != nil || !strings.HasPrefix(branch, "polecat/Toast/gt-new@") {
+		t.Errorf("current branch = %q (%v), want a fresh polecat/Toast/gt-new@ branch", branch, err)
+	}
+	if ok, _ := g.BranchExists("polecat/Toast/gt-old@1"); !ok {
+		t.Error("finished branch was removed; the merge queue still needs it")
+	}
+
+	// Nothing further queued: gt done proceeds to POLECAT_DONE as usual
+	writeHookState(t, stateDir, "gt-new", "hooked", agent)
+	if next := pickUpQueuedWork(worktree, townRoot, g, "main"); next != "" {
+		t.Errorf("pickUpQueuedWork() with nothing queued = %q", next)
+	}
+}
+
+func TestParseOnExistingPolicy(t *testing.T) {
+	for in, want := range map[string]OnExistingPolicy{
+		"":        OnExistingReject,
+		"reject":  OnExistingReject,
+		"Queue":   OnExistingQueue,
+		"replace": OnExistingReplace,
+	} {
+		got, err := parseOnExistingPolicy(in)
+		if err != nil || got != want {
+			t.Errorf("parseOnExistingPolicy(%q) = (%q, %v), want %q", in, got, err, want)
+		}
+	}
+	if _, err := parseOnExistingPolicy("overwrite"); err == nil {
+		t.Error("parseOnExistingPolicy(\"overwrite\") should fail")
+	}
+}
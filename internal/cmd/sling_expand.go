@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/steveyegge/gastown/internal/beads"
+)
+
+// runSlingExpand handles gt sling <epic> <rig> --expand: instead of hooking
+// the epic, it batch-slings the epic's ready children to the rig.
+func runSlingExpand(args []string, beadID, formulaName, townRoot string) error {
+	if beadID == "" || formulaName != "" || len(args) != 2 {
+		return fmt.Errorf("--expand requires: gt sling <epic> <rig>")
+	}
+	rigName, isRig := IsRigName(townRoot, args[1])
+	if !isRig {
+		return fmt.Errorf("--expand target must be a rig, got '%s'", args[1])
+	}
+
+	children, err := readyEpicChildren(beadsForBead(townRoot, beadID, ""), beadID)
+	if err != nil {
+		return err
+	}
+	if len(children) == 0 {
+		return fmt.Errorf("%s has no ready children to sling", beadID)
+	}
+
+	return runBatchSling(children, rigName, filepath.Join(townRoot, ".beads"))
+}
+
+// readyEpicChildren returns the IDs of epicID's children that are ready to
+// sling: open, unassigned, and not blocked by an unclosed bead.
+func readyEpicChildren(b *beads.Beads, epicID string) ([]string, error) {
+	children, err := b.List(beads.ListOptions{Parent: epicID, Status: "open", Priority: -1})
+	if err != nil {
+		return nil, fmt.Errorf("listing children of %s: %w", epicID, err)
+	}
+
+	var candidates []string
+	for _, child := range children {
+		if child.Assignee == "" {
+			candidates = append(candidates, child.ID)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+
+	// List output omits dependency details, so fetch them to find blockers.
+	issues, _, err := b.ShowOrdered(candidates)
+	if err != nil {
+		return nil, fmt.Errorf("loading children of %s: %w", epicID, err)
+	}
+
+	var ids []string
+	for _, issue := range issues {
+		if !isBlockedBead(issue) {
+			ids = append(ids, issue.ID)
+		}
+	}
+	return ids, nil
+}
+
+// isBlockedBead reports whether issue has an unclosed blocking dependency.
+func isBlockedBead(issue *beads.Issue) bool {
+	return beads.IsBlocked(issue)
+}
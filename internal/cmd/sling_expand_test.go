@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/beads"
+)
+
+func TestReadyEpicChildren(t *testing.T) {
+	binDir := t.TempDir()
+	script := `#!/bin/sh
+while [ "$1" = "--allow-stale" ] || [ "$1" = "--no-daemon" ]; do shift; done
+case "$1" in
+  list)
+    echo '[{"id":"gt-a"},{"id":"gt-b"},{"id":"gt-c","assignee":"gastown/polecats/Toast"},{"id":"gt-d"}]'
+    ;;
+  show)
+    echo '[{"id":"gt-a","status":"open"},
+{"id":"gt-b","status":"open","dependencies":[{"id":"gt-a","status":"open","dependency_type":"blocks"}]},
+{"id":"gt-d","status":"open","dependencies":[{"id":"gt-epic","status":"open","dependency_type":"parent-child"},
+                                             {"id":"gt-old","status":"closed","dependency_type":"blocks"}]}]'
+    ;;
+esac
+exit 0
+`
+	if err := os.WriteFile(filepath.Join(binDir, "bd"), []byte(script), 0755); err != nil {
+		t.Fatalf("write bd stub: %v", err)
+	}
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	ids, err := readyEpicChildren(beads.New(t.TempDir()), "gt-epic")
+	if err != nil {
+		t.Fatalf("readyEpicChildren: %v", err)
+	}
+	// gt-b is blocked by open gt-a; gt-c is already assigned.
+	if got := fmt.Sprint(ids); got != "[gt-a gt-d]" {
+		t.Errorf("ready children = %s, want [gt-a gt-d]", got)
+	}
+}
+
+func TestRunSlingExpandRequiresRigTarget(t *testing.T) {
+	if err := runSlingExpand([]string{"gt-epic"}, "gt-epic", "", t.TempDir()); err == nil {
+		t.Error("runSlingExpand without a target should fail")
+	}
+	if err := runSlingExpand([]string{"mol-review", "gastown"}, "gt-epic", "mol-review", t.TempDir()); err == nil {
+		t.Error("runSlingExpand with a formula should fail")
+	}
+}
@@ -5,10 +5,8 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
-	"path/filepath"
 	"strings"
 
-	"github.com/steveyegge/gastown/internal/beads"
 	"github.com/steveyegge/gastown/internal/events"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/tmux"
@@ -79,7 +77,6 @@ func runSlingFormula(args []string) error {
 	if err != nil {
 		return fmt.Errorf("finding town root: %w", err)
 	}
-	townBeadsDir := filepath.Join(townRoot, ".beads")
 
 	// Determine target (self or specified)
 	var target string
@@ -112,7 +109,7 @@ func runSlingFormula(args []string) error {
 				targetPane = "<dog-pane>"
 			} else {
 				// Dispatch to dog
-				dispatchInfo, dispatchErr := DispatchToDog(dogName, slingCreate)
+				dispatchInfo, dispatchErr := DispatchToDog(townRoot, dogName, formulaName, slingCreate)
 				if dispatchErr != nil {
 					return fmt.Errorf("dispatching to dog: %w", dispatchErr)
 				}
@@ -120,7 +117,7 @@ func runSlingFormula(args []string) error {
 				targetPane = dispatchInfo.Pane
 				fmt.Printf("Dispatched to dog %s\n", dispatchInfo.DogName)
 			}
-		} else if rigName, isRig := IsRigName(target); isRig {
+		} else if rigName, isRig := IsRigName(townRoot, target); isRig {
 			// Check if target is a rig name (auto-spawn polecat)
 			if slingDryRun {
 				// Dry run - just indicate what would happen
@@ -144,12 +141,12 @@ func runSlingFormula(args []string) error {
 				targetPane = spawnInfo.Pane
 
 				// Wake witness and refinery to monitor the new polecat
-				wakeRigAgents(rigName)
+				reportWake(wakeRigAgents(rigName))
 			}
 		} else {
 			// Slinging to an existing agent
 			var targetWorkDir string
-			targetAgent, targetPane, targetWorkDir, err = resolveTargetAgent(target)
+			targetAgent, targetPane, targetWorkDir, err = resolveTargetAgent(townRoot, target)
 			if err != nil {
 				return fmt.Errorf("resolving target: %w", err)
 			}
@@ -212,45 +209,36 @@ func runSlingFormula(args []string) error {
 
 	// Record the attached molecule in the wisp's description.
 	// This is required for gt hook to recognize the molecule attachment.
-	if err := storeAttachedMoleculeInBead(wispRootID, wispRootID); err != nil {
+	wispBeads := beadsForBead(townRoot, wispRootID, "")
+	if err := wispBeads.SetAttachedMolecule(wispRootID, wispRootID); err != nil {
 		// Warn but don't fail - polecat can still work through steps
 		fmt.Printf("%s Could not store attached_molecule: %v\n", style.Dim.Render("Warning:"), err)
 	}
+	if err := wispBeads.SetWispLineage(wispRootID, formulaName, ""); err != nil {
+		fmt.Printf("%s Could not store wisp lineage: %v\n", style.Dim.Render("Warning:"), err)
+	}
 
-	// Step 3: Hook the wisp bead using bd update.
-	// See: https://github.com/steveyegge/gastown/issues/148
-	hookCmd := exec.Command("bd", "--no-daemon", "update", wispRootID, "--status=hooked", "--assignee="+targetAgent)
-	hookCmd.Dir = beads.ResolveHookDir(townRoot, wispRootID, "")
-	hookCmd.Stderr = os.Stderr
-	if err := hookCmd.Run(); err != nil {
+	// Step 3: Hook the wisp bead in its own database
+	if err := validateAgentBeadPrefix(townRoot, targetAgent); err != nil {
+		return err
+	}
+	if err := hookBead(wispBeads, wispRootID, targetAgent); err != nil {
 		return fmt.Errorf("hooking wisp bead: %w", err)
 	}
 	fmt.Printf("%s Attached to hook (status=hooked)\n", style.Bold.Render("✓"))
 
 	// Log sling event to activity feed (formula slinging)
-	actor := detectActor()
+	actor := ResolveActor("")
 	payload := events.SlingPayload(wispRootID, targetAgent)
 	payload["formula"] = formulaName
 	_ = events.LogFeed(events.TypeSling, actor, payload)
 
 	// Update agent bead's hook_bead field (ZFC: agents track their current work)
 	// Note: formula slinging uses town root as workDir (no polecat-specific path)
-	updateAgentHookBead(targetAgent, wispRootID, "", townBeadsDir)
-
-	// Store dispatcher in bead description (enables completion notification to dispatcher)
-	if err := storeDispatcherInBead(wispRootID, actor); err != nil {
-		// Warn but don't fail - polecat will still complete work
-		fmt.Printf("%s Could not store dispatcher in bead: %v\n", style.Dim.Render("Warning:"), err)
-	}
+	updateAgentHookBead(townRoot, targetAgent, wispRootID, "")
 
-	// Store args in wisp bead if provided (no-tmux mode: beads as data plane)
-	if slingArgs != "" {
-		if err := storeArgsInBead(wispRootID, slingArgs); err != nil {
-			fmt.Printf("%s Could not store args in bead: %v\n", style.Dim.Render("Warning:"), err)
-		} else {
-			fmt.Printf("%s Args stored in bead (durable)\n", style.Bold.Render("✓"))
-		}
-	}
+	// Store dispatcher and args in wisp bead (no-tmux mode: beads as data plane)
+	recordSlingMetadata(wispBeads, wispRootID, actor, slingArgs, "")
 
 	// Step 4: Nudge to start (graceful if no tmux)
 	if targetPane == "" {
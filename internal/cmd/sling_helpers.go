@@ -2,9 +2,11 @@ package cmd
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -13,7 +15,6 @@ import (
 	"github.com/steveyegge/gastown/internal/constants"
 	"github.com/steveyegge/gastown/internal/style"
 	"github.com/steveyegge/gastown/internal/tmux"
-	"github.com/steveyegge/gastown/internal/workspace"
 )
 
 // beadInfo holds status and assignee for a bead.
@@ -21,52 +22,45 @@ type beadInfo struct {
 	Title    string `json:"title"`
 	Status   string `json:"status"`
 	Assignee string `json:"assignee"`
+	Type     string `json:"issue_type"`
 }
 
-// verifyBeadExists checks that the bead exists using bd show.
+// showBeadRouted runs bd show for a bead from townRoot ("" runs from the
+// current directory).
 // Uses bd's native prefix-based routing via routes.jsonl - do NOT set BEADS_DIR
 // as that overrides routing and breaks resolution of rig-level beads.
 //
 // Uses --no-daemon with --allow-stale to avoid daemon socket timing issues
 // while still finding beads when database is out of sync with JSONL.
+// beads.RunBD reports a not-found hidden by the --no-daemon exit 0 bug as
+// beads.ErrNotFound.
+func showBeadRouted(townRoot, beadID string) ([]byte, error) {
+	out, err := beads.RunBD(townRoot, "--no-daemon", "show", beadID, "--json", "--allow-stale")
+	if err == nil && len(out) == 0 {
+		err = beads.ErrNotFound
+	}
+	return out, err
+}
+
+// verifyBeadExists checks that the bead exists using bd show.
 // For existence checks, stale data is acceptable - we just need to know it exists.
-func verifyBeadExists(beadID string) error {
-	cmd := exec.Command("bd", "--no-daemon", "show", beadID, "--json", "--allow-stale")
-	// Run from town root so bd can find routes.jsonl for prefix-based routing.
-	// Do NOT set BEADS_DIR - that overrides routing and breaks rig bead resolution.
-	if townRoot, err := workspace.FindFromCwd(); err == nil {
-		cmd.Dir = townRoot
-	}
-	// Use Output() instead of Run() to detect bd --no-daemon exit 0 bug:
-	// when issue not found, --no-daemon exits 0 but produces empty stdout.
-	out, err := cmd.Output()
-	if err != nil {
-		return fmt.Errorf("bead '%s' not found (bd show failed)", beadID)
-	}
-	if len(out) == 0 {
-		return fmt.Errorf("bead '%s' not found", beadID)
+func verifyBeadExists(townRoot, beadID string) error {
+	if _, err := showBeadRouted(townRoot, beadID); err != nil {
+		if errors.Is(err, beads.ErrNotFound) {
+			return slingUserError(fmt.Errorf("bead '%s' not found", beadID))
+		}
+		return fmt.Errorf("checking bead '%s': %w", beadID, err)
 	}
 	return nil
 }
 
 // getBeadInfo returns status and assignee for a bead.
-// Uses bd's native prefix-based routing via routes.jsonl.
-// Uses --no-daemon with --allow-stale for consistency with verifyBeadExists.
-func getBeadInfo(beadID string) (*beadInfo, error) {
-	cmd := exec.Command("bd", "--no-daemon", "show", beadID, "--json", "--allow-stale")
-	// Run from town root so bd can find routes.jsonl for prefix-based routing.
-	if townRoot, err := workspace.FindFromCwd(); err == nil {
-		cmd.Dir = townRoot
-	}
-	out, err := cmd.Output()
+// Uses the same routed lookup as verifyBeadExists.
+func getBeadInfo(townRoot, beadID string) (*beadInfo, error) {
+	out, err := showBeadRouted(townRoot, beadID)
 	if err != nil {
 		return nil, fmt.Errorf("bead '%s' not found", beadID)
 	}
-	// Handle bd --no-daemon exit 0 bug: when issue not found,
-	// --no-daemon exits 0 but produces empty stdout (error goes to stderr).
-	if len(out) == 0 {
-		return nil, fmt.Errorf("bead '%s' not found", beadID)
-	}
 	// bd show --json returns an array (issue + dependents), take first element
 	var infos []beadInfo
 	if err := json.Unmarshal(out, &infos); err != nil {
@@ -78,146 +72,11 @@ func getBeadInfo(beadID string) (*beadInfo, error) {
 	return &infos[0], nil
 }
 
-// storeArgsInBead stores args in the bead's description using attached_args field.
-// This enables no-tmux mode where agents discover args via gt prime / bd show.
-func storeArgsInBead(beadID, args string) error {
-	// Get the bead to preserve existing description content
-	showCmd := exec.Command("bd", "--no-daemon", "show", beadID, "--json", "--allow-stale")
-	out, err := showCmd.Output()
-	if err != nil {
-		return fmt.Errorf("fetching bead: %w", err)
-	}
-	// Handle bd --no-daemon exit 0 bug: empty stdout means not found
-	if len(out) == 0 {
-		return fmt.Errorf("bead not found")
-	}
-
-	// Parse the bead
-	var issues []beads.Issue
-	if err := json.Unmarshal(out, &issues); err != nil {
-		return fmt.Errorf("parsing bead: %w", err)
-	}
-	if len(issues) == 0 {
-		return fmt.Errorf("bead not found")
-	}
-	issue := &issues[0]
-
-	// Get or create attachment fields
-	fields := beads.ParseAttachmentFields(issue)
-	if fields == nil {
-		fields = &beads.AttachmentFields{}
-	}
-
-	// Set the args
-	fields.AttachedArgs = args
-
-	// Update the description
-	newDesc := beads.SetAttachmentFields(issue, fields)
-
-	// Update the bead
-	updateCmd := exec.Command("bd", "--no-daemon", "update", beadID, "--description="+newDesc)
-	updateCmd.Stderr = os.Stderr
-	if err := updateCmd.Run(); err != nil {
-		return fmt.Errorf("updating bead description: %w", err)
-	}
-
-	return nil
-}
-
-// storeDispatcherInBead stores the dispatcher agent ID in the bead's description.
-// This enables polecats to notify the dispatcher when work is complete.
-func storeDispatcherInBead(beadID, dispatcher string) error {
-	if dispatcher == "" {
-		return nil
-	}
-
-	// Get the bead to preserve existing description content
-	showCmd := exec.Command("bd", "show", beadID, "--json")
-	out, err := showCmd.Output()
-	if err != nil {
-		return fmt.Errorf("fetching bead: %w", err)
-	}
-
-	// Parse the bead
-	var issues []beads.Issue
-	if err := json.Unmarshal(out, &issues); err != nil {
-		return fmt.Errorf("parsing bead: %w", err)
-	}
-	if len(issues) == 0 {
-		return fmt.Errorf("bead not found")
-	}
-	issue := &issues[0]
-
-	// Get or create attachment fields
-	fields := beads.ParseAttachmentFields(issue)
-	if fields == nil {
-		fields = &beads.AttachmentFields{}
-	}
-
-	// Set the dispatcher
-	fields.DispatchedBy = dispatcher
-
-	// Update the description
-	newDesc := beads.SetAttachmentFields(issue, fields)
-
-	// Update the bead
-	updateCmd := exec.Command("bd", "update", beadID, "--description="+newDesc)
-	updateCmd.Stderr = os.Stderr
-	if err := updateCmd.Run(); err != nil {
-		return fmt.Errorf("updating bead description: %w", err)
-	}
-
-	return nil
-}
-
-// storeAttachedMoleculeInBead sets the attached_molecule field in a bead's description.
-// This is required for gt hook to recognize that a molecule is attached to the bead.
-// Called after bonding a formula wisp to a bead via "gt sling <formula> --on <bead>".
-func storeAttachedMoleculeInBead(beadID, moleculeID string) error {
-	if moleculeID == "" {
-		return nil
-	}
-
-	// Get the bead to preserve existing description content
-	showCmd := exec.Command("bd", "show", beadID, "--json")
-	out, err := showCmd.Output()
-	if err != nil {
-		return fmt.Errorf("fetching bead: %w", err)
-	}
-
-	// Parse the bead
-	var issues []beads.Issue
-	if err := json.Unmarshal(out, &issues); err != nil {
-		return fmt.Errorf("parsing bead: %w", err)
-	}
-	if len(issues) == 0 {
-		return fmt.Errorf("bead not found")
-	}
-	issue := &issues[0]
-
-	// Get or create attachment fields
-	fields := beads.ParseAttachmentFields(issue)
-	if fields == nil {
-		fields = &beads.AttachmentFields{}
-	}
-
-	// Set the attached molecule
-	fields.AttachedMolecule = moleculeID
-	if fields.AttachedAt == "" {
-		fields.AttachedAt = time.Now().UTC().Format(time.RFC3339)
-	}
-
-	// Update the description
-	newDesc := beads.SetAttachmentFields(issue, fields)
-
-	// Update the bead
-	updateCmd := exec.Command("bd", "update", beadID, "--description="+newDesc)
-	updateCmd.Stderr = os.Stderr
-	if err := updateCmd.Run(); err != nil {
-		return fmt.Errorf("updating bead description: %w", err)
-	}
-
-	return nil
+// beadsForBead returns a Beads wrapper rooted at the rig that owns beadID.
+// bd update doesn't follow prefix routes, so description writes (args,
+// dispatcher, attached molecule) must run from the owning rig's directory.
+func beadsForBead(townRoot, beadID, hookWorkDir string) *beads.Beads {
+	return beads.New(beads.ResolveHookDir(townRoot, beadID, hookWorkDir))
 }
 
 // injectStartPrompt sends a prompt to the target pane to start working.
@@ -313,50 +172,68 @@ func detectCloneRoot() (string, error) {
 	return strings.TrimSpace(string(out)), nil
 }
 
-// detectActor returns the current agent's actor string for event logging.
-func detectActor() string {
-	roleInfo, err := GetRole()
-	if err != nil {
-		return "unknown"
-	}
-	return roleInfo.ActorString()
-}
-
 // agentIDToBeadID converts an agent ID to its corresponding agent bead ID.
 // Uses canonical naming: prefix-rig-role-name
 // Town-level agents (Mayor, Deacon) use hq- prefix and are stored in town beads.
 // Rig-level agents use the rig's configured prefix (default "gt-").
 // townRoot is needed to look up the rig's configured prefix.
 func agentIDToBeadID(agentID, townRoot string) string {
-	// Handle simple cases (town-level agents with hq- prefix)
-	if agentID == "mayor" {
-		return beads.MayorBeadIDTown()
+	id, err := ParseAgentID(agentID)
+	if err != nil {
+		return ""
 	}
-	if agentID == "deacon" {
-		return beads.DeaconBeadIDTown()
+	return id.BeadID(townRoot)
+}
+
+// validateAgentBeadPrefix checks that an agent's bead ID belongs to its
+// rig's database before any slot operation (see validateRigAgentPrefix).
+// Otherwise the hook slot set fails later as a buried warning (go-19z).
+// Town-level agents and unparseable agent IDs are not checked.
+func validateAgentBeadPrefix(townRoot, agentID string) error {
+	id, err := ParseAgentID(agentID)
+	if err != nil || id.Rig() == "" {
+		return nil
 	}
+	agentBeadID := id.BeadID(townRoot)
+	if err := checkRigAgentPrefix(townRoot, id.Rig(), beads.ExtractPrefix(agentBeadID)); err != nil {
+		return fmt.Errorf("agent bead %s for %s: %w", agentBeadID, agentID, err)
+	}
+	return nil
+}
 
-	// Parse path-style agent IDs
-	parts := strings.Split(agentID, "/")
-	if len(parts) < 2 {
-		return ""
+// validateRigAgentPrefix is validateAgentBeadPrefix for a polecat not yet
+// spawned in rigName, so a misconfigured rig is caught before anything is
+// created. All of a rig's agent beads share its prefix.
+func validateRigAgentPrefix(townRoot, rigName string) error {
+	prefix := strings.TrimSuffix(beads.GetPrefixForRig(townRoot, rigName), "-") + "-"
+	if err := checkRigAgentPrefix(townRoot, rigName, prefix); err != nil {
+		return fmt.Errorf("agent beads for %s: %w", rigName, err)
 	}
+	return nil
+}
 
-	rig := parts[0]
-	prefix := beads.GetPrefixForRig(townRoot, rig)
+// checkRigAgentPrefix checks an agent bead prefix for rigName: it must match
+// the rig's prefix in mayor/rigs.json and must route to the rig in
+// routes.jsonl.
+func checkRigAgentPrefix(townRoot, rigName, prefix string) error {
+	rigsConfig, err := config.LoadRigsConfig(filepath.Join(townRoot, "mayor", "rigs.json"))
+	if err == nil {
+		if entry, ok := rigsConfig.Rigs[rigName]; ok && entry.BeadsConfig != nil && entry.BeadsConfig.Prefix != "" {
+			configured := strings.TrimSuffix(entry.BeadsConfig.Prefix, "-") + "-"
+			if prefix != configured {
+				return fmt.Errorf("prefix %q but rig %s is configured with %q: routes.jsonl and mayor/rigs.json disagree",
+					prefix, rigName, configured)
+			}
+		}
+	}
 
-	switch {
-	case len(parts) == 2 && parts[1] == "witness":
-		return beads.WitnessBeadIDWithPrefix(prefix, rig)
-	case len(parts) == 2 && parts[1] == "refinery":
-		return beads.RefineryBeadIDWithPrefix(prefix, rig)
-	case len(parts) == 3 && parts[1] == "crew":
-		return beads.CrewBeadIDWithPrefix(prefix, rig, parts[2])
-	case len(parts) == 3 && parts[1] == "polecats":
-		return beads.PolecatBeadIDWithPrefix(prefix, rig, parts[2])
-	default:
-		return ""
+	if rigPath := beads.GetRigPathForPrefix(townRoot, prefix); rigPath != "" {
+		rel, err := filepath.Rel(townRoot, rigPath)
+		if err != nil || strings.Split(filepath.ToSlash(rel), "/")[0] != rigName {
+			return fmt.Errorf("prefix %q routes to %s instead of rig %s", prefix, rigPath, rigName)
+		}
 	}
+	return nil
 }
 
 // updateAgentHookBead updates the agent bead's state and hook when work is slung.
@@ -369,19 +246,12 @@ func agentIDToBeadID(agentID, townRoot string) string {
 // For cross-database scenarios (agent in rig db, hook bead in town db),
 // the slot set may fail - this is handled gracefully with a warning.
 // The work is still correctly attached via `bd update <bead> --assignee=<agent>`.
-func updateAgentHookBead(agentID, beadID, workDir, townBeadsDir string) {
-	_ = townBeadsDir // Not used - BEADS_DIR breaks redirect mechanism
-
+// BEADS_DIR is deliberately not set: it breaks the redirect mechanism.
+func updateAgentHookBead(townRoot, agentID, beadID, workDir string) {
 	// Determine the directory to run bd commands from:
 	// - If workDir is provided (polecat's clone path), use it for redirect-based routing
 	// - Otherwise fall back to town root
 	bdWorkDir := workDir
-	townRoot, err := workspace.FindFromCwd()
-	if err != nil {
-		// Not in a Gas Town workspace - can't update agent bead
-		fmt.Fprintf(os.Stderr, "Warning: couldn't find town root to update agent hook: %v\n", err)
-		return
-	}
 	if bdWorkDir == "" {
 		bdWorkDir = townRoot
 	}
@@ -409,21 +279,63 @@ func updateAgentHookBead(agentID, beadID, workDir, townBeadsDir string) {
 	}
 }
 
+// WakeResult reports the outcome of wakeRigAgents.
+type WakeResult struct {
+	TmuxUnavailable bool     // tmux is not installed; boot and nudges were skipped
+	Unwoken         []string // Sessions that could not be nudged
+}
+
+// Summary describes agents that could not be woken, or "" if all were.
+func (r WakeResult) Summary() string {
+	if len(r.Unwoken) == 0 {
+		return ""
+	}
+	if r.TmuxUnavailable {
+		return "tmux unavailable, not waking " + strings.Join(r.Unwoken, ", ") + " (they will find work on next start)"
+	}
+	return "could not wake " + strings.Join(r.Unwoken, ", ")
+}
+
 // wakeRigAgents wakes the witness and refinery for a rig after polecat dispatch.
 // This ensures the patrol agents are ready to monitor and merge.
-func wakeRigAgents(rigName string) {
+// Without tmux, nothing can be booted or nudged, so both are skipped and
+// reported unwoken. A session that does not exist yet (still booting) is not
+// nudged; it finds the work when it starts. Only a nudge to a running
+// session that fails is reported.
+func wakeRigAgents(rigName string) WakeResult {
+	witnessSession := fmt.Sprintf("gt-%s-witness", rigName)
+	refinerySession := fmt.Sprintf("gt-%s-refinery", rigName)
+
+	t := tmux.NewTmux()
+	if !t.IsAvailable() {
+		return WakeResult{TmuxUnavailable: true, Unwoken: []string{witnessSession, refinerySession}}
+	}
+
 	// Boot the rig (idempotent - no-op if already running)
 	bootCmd := exec.Command("gt", "rig", "boot", rigName)
 	_ = bootCmd.Run() // Ignore errors - rig might already be running
 
 	// Nudge witness and refinery to clear any backoff
-	t := tmux.NewTmux()
-	witnessSession := fmt.Sprintf("gt-%s-witness", rigName)
-	refinerySession := fmt.Sprintf("gt-%s-refinery", rigName)
+	var result WakeResult
+	for _, n := range []struct{ session, msg string }{
+		{witnessSession, "Polecat dispatched - check for work"},
+		{refinerySession, "Polecat dispatched - check for merge requests"},
+	} {
+		if has, _ := t.HasSession(n.session); !has {
+			continue
+		}
+		if err := t.NudgeSession(n.session, n.msg); err != nil {
+			result.Unwoken = append(result.Unwoken, n.session)
+		}
+	}
+	return result
+}
 
-	// Silent nudges - sessions might not exist yet
-	_ = t.NudgeSession(witnessSession, "Polecat dispatched - check for work")
-	_ = t.NudgeSession(refinerySession, "Polecat dispatched - check for merge requests")
+// reportWake prints a wakeRigAgents result that left agents unwoken.
+func reportWake(result WakeResult) {
+	if summary := result.Summary(); summary != "" {
+		fmt.Printf("%s Warning: %s\n", style.Dim.Render("○"), summary)
+	}
 }
 
 // isPolecatTarget checks if the target string refers to a polecat.
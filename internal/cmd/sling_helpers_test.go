@@ -0,0 +1,124 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/config"
+)
+
+// setupPrefixTown writes a town with the given routes.jsonl lines and a
+// rigs.json configuring rig "gastown" with prefix rigPrefix.
+func setupPrefixTown(t *testing.T, rigPrefix string, routes ...string) string {
+	t.Helper()
+	townRoot := t.TempDir()
+	for _, dir := range []string{".beads", "mayor"} {
+		if err := os.MkdirAll(filepath.Join(townRoot, dir), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	data := strings.Join(routes, "\n") + "\n"
+	if err := os.WriteFile(filepath.Join(townRoot, ".beads", "routes.jsonl"), []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+	rigsConfig := &config.RigsConfig{
+		Version: 1,
+		Rigs: map[string]config.RigEntry{
+			"gastown": {BeadsConfig: &config.BeadsConfig{Prefix: rigPrefix}},
+		},
+	}
+	if err := config.SaveRigsConfig(filepath.Join(townRoot, "mayor", "rigs.json"), rigsConfig); err != nil {
+		t.Fatal(err)
+	}
+	return townRoot
+}
+
+func TestValidateAgentBeadPrefix(t *testing.T) {
+	t.Run("matching prefix", func(t *testing.T) {
+		townRoot := setupPrefixTown(t, "gt-", `{"prefix":"gt-","path":"gastown/mayor/rig"}`)
+		if err := validateAgentBeadPrefix(townRoot, "gastown/polecats/Toast"); err != nil {
+			t.Errorf("validateAgentBeadPrefix = %v, want nil", err)
+		}
+	})
+
+	t.Run("routes disagree with rig config", func(t *testing.T) {
+		townRoot := setupPrefixTown(t, "gt-", `{"prefix":"go-","path":"gastown/mayor/rig"}`)
+		err := validateAgentBeadPrefix(townRoot, "gastown/polecats/Toast")
+		if err == nil {
+			t.Fatal("expected error for mismatched prefix")
+		}
+		for _, want := range []string{"go-gastown-polecat-Toast", `"go-"`, `"gt-"`, "rigs.json"} {
+			if !strings.Contains(err.Error(), want) {
+				t.Errorf("error %q missing %q", err, want)
+			}
+		}
+	})
+
+	t.Run("prefix routes to another rig", func(t *testing.T) {
+		// No route for gastown, so its configured gt- prefix is used, but gt-
+		// belongs to the beads rig
+		townRoot := setupPrefixTown(t, "gt-", `{"prefix":"gt-","path":"beads/mayor/rig"}`)
+		err := validateAgentBeadPrefix(townRoot, "gastown/crew/max")
+		if err == nil || !strings.Contains(err.Error(), "instead of rig gastown") {
+			t.Errorf("validateAgentBeadPrefix = %v, want routing error", err)
+		}
+	})
+
+	t.Run("town-level agent", func(t *testing.T) {
+		townRoot := setupPrefixTown(t, "gt-", `{"prefix":"go-","path":"gastown/mayor/rig"}`)
+		if err := validateAgentBeadPrefix(townRoot, "mayor"); err != nil {
+			t.Errorf("validateAgentBeadPrefix(mayor) = %v, want nil", err)
+		}
+	})
+}
+
+func TestValidateRigAgentPrefix(t *testing.T) {
+	// Checked before a polecat is spawned, with no agent name yet
+	if err := validateRigAgentPrefix(setupPrefixTown(t, "gt-", `{"prefix":"gt-","path":"gastown/mayor/rig"}`), "gastown"); err != nil {
+		t.Errorf("validateRigAgentPrefix = %v, want nil", err)
+	}
+	err := validateRigAgentPrefix(setupPrefixTown(t, "gt-", `{"prefix":"go-","path":"gastown/mayor/rig"}`), "gastown")
+	if err == nil || !strings.Contains(err.Error(), "rigs.json") {
+		t.Errorf("validateRigAgentPrefix = %v, want prefix mismatch", err)
+	}
+}
+
+// TestSlingHelpersUseTownRoot checks that the sling helpers run bd from the
+// town root they are given, not from the test's working directory.
+func TestSlingHelpersUseTownRoot(t *testing.T) {
+	townRoot := setupPrefixTown(t, "gt-", `{"prefix":"gt-","path":"gastown/mayor/rig"}`)
+	binDir := t.TempDir()
+	logPath := filepath.Join(binDir, "bd.log")
+	script := "#!/bin/sh\necho \"$(pwd) $*\" >> " + logPath + "\n" +
+		"printf '%s\\n' '[{\"title\":\"Work\",\"status\":\"open\",\"assignee\":\"\"}]'\n"
+	if err := os.WriteFile(filepath.Join(binDir, "bd"), []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	if _, err := getBeadInfo(townRoot, "gt-abc"); err != nil {
+		t.Fatalf("getBeadInfo: %v", err)
+	}
+	updateAgentHookBead(townRoot, "gastown/polecats/Toast", "gt-abc", "")
+
+	data, err := os.ReadFile(logPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("bd calls = %q, want show and slot set", lines)
+	}
+	realTown, _ := filepath.EvalSymlinks(townRoot)
+	for _, line := range lines {
+		dir := strings.SplitN(line, " ", 2)[0]
+		if realDir, _ := filepath.EvalSymlinks(dir); realDir != realTown {
+			t.Errorf("bd ran in %s, want town root %s (%s)", dir, townRoot, line)
+		}
+	}
+	if !strings.Contains(lines[1], "slot set gt-gastown-polecat-Toast hook gt-abc") {
+		t.Errorf("hook not set on agent bead: %s", lines[1])
+	}
+}
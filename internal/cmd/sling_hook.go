@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+// slingBeads is the subset of *beads.Beads the sling hook steps use.
+// Taking it as a parameter lets tests exercise those steps with a fake
+// instead of a real bd binary.
+type slingBeads interface {
+	Hook(beadID, agentID string) error
+	TryClaim(beadID, agentID string) (bool, error)
+	SetDispatchedBy(id, dispatcher string) error
+	SetArgs(id, args string) error
+}
+
+// hookBead puts beadID on targetAgent's hook (status=hooked, assignee=agent).
+// Callers pass the Beads for the bead's own database (see beadsForBead) so
+// cross-rig beads are hooked where they live.
+// See: https://github.com/steveyegge/gastown/issues/148
+func hookBead(b slingBeads, beadID, targetAgent string) error {
+	if err := b.Hook(beadID, targetAgent); err != nil {
+		return fmt.Errorf("hooking bead: %w", err)
+	}
+	return nil
+}
+
+// ErrBeadClaimed is returned when another agent holds the bead being slung.
+var ErrBeadClaimed = errors.New("bead already claimed by another agent")
+
+// claimBead hooks beadID to targetAgent. Unless force is set, it claims the
+// bead atomically (beads.TryClaim) so two dispatchers slinging the same bead
+// cannot both hook it; the loser gets ErrBeadClaimed.
+func claimBead(b slingBeads, beadID, targetAgent string, force bool) error {
+	if force {
+		return hookBead(b, beadID, targetAgent)
+	}
+	ok, err := b.TryClaim(beadID, targetAgent)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("%w: %s\nUse --force to re-sling", ErrBeadClaimed, beadID)
+	}
+	return nil
+}
+
+// recordSlingMetadata stores the dispatcher (for completion notification) and
+// any --args on a hooked bead. Failures are warnings: the polecat can still
+// complete the work, and args are also delivered in the nudge.
+func recordSlingMetadata(b slingBeads, beadID, dispatcher, args, indent string) {
+	if err := b.SetDispatchedBy(beadID, dispatcher); err != nil {
+		fmt.Printf("%s%s Could not store dispatcher in bead: %v\n", indent, style.Dim.Render("Warning:"), err)
+	}
+
+	if args == "" {
+		return
+	}
+	if err := b.SetArgs(beadID, args); err != nil {
+		fmt.Printf("%s%s Could not store args in bead: %v\n", indent, style.Dim.Render("Warning:"), err)
+	} else {
+		fmt.Printf("%s%s Args stored in bead (durable)\n", indent, style.Bold.Render("✓"))
+	}
+}
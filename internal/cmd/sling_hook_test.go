@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/beads"
+)
+
+// fakeSlingBeads records the sling hook steps without a bd binary.
+type fakeSlingBeads struct {
+	status, assignee string
+	dispatchedBy     string
+	args             string
+	setArgsErr       error
+}
+
+func (f *fakeSlingBeads) TryClaim(beadID, agentID string) (bool, error) {
+	if f.assignee != "" && f.assignee != agentID {
+		return false, nil
+	}
+	f.status, f.assignee = beads.StatusHooked, agentID
+	return true, nil
+}
+
+func (f *fakeSlingBeads) Hook(beadID, agentID string) error {
+	f.status, f.assignee = beads.StatusHooked, agentID
+	return nil
+}
+
+func (f *fakeSlingBeads) SetDispatchedBy(id, dispatcher string) error {
+	f.dispatchedBy = dispatcher
+	return nil
+}
+
+func (f *fakeSlingBeads) SetArgs(id, args string) error {
+	if f.setArgsErr != nil {
+		return f.setArgsErr
+	}
+	f.args = args
+	return nil
+}
+
+var _ slingBeads = (*beads.Beads)(nil)
+
+func TestHookBeadAndRecordMetadata(t *testing.T) {
+	fake := &fakeSlingBeads{}
+
+	if err := hookBead(fake, "gt-abc", "gastown/polecats/Toast"); err != nil {
+		t.Fatalf("hookBead() = %v", err)
+	}
+	recordSlingMetadata(fake, "gt-abc", "mayor", "patch release", "")
+
+	if fake.status != beads.StatusHooked || fake.assignee != "gastown/polecats/Toast" {
+		t.Errorf("hook = (%q, %q), want (hooked, gastown/polecats/Toast)", fake.status, fake.assignee)
+	}
+	if fake.dispatchedBy != "mayor" {
+		t.Errorf("dispatched_by = %q, want mayor", fake.dispatchedBy)
+	}
+	if fake.args != "patch release" {
+		t.Errorf("args = %q, want %q", fake.args, "patch release")
+	}
+}
+
+func TestRecordSlingMetadataToleratesFailures(t *testing.T) {
+	fake := &fakeSlingBeads{setArgsErr: errors.New("bd unavailable")}
+
+	// Metadata failures are warnings; the dispatcher is still stored.
+	recordSlingMetadata(fake, "gt-abc", "mayor", "patch release", "  ")
+	if fake.dispatchedBy != "mayor" || fake.args != "" {
+		t.Errorf("got dispatched_by=%q args=%q", fake.dispatchedBy, fake.args)
+	}
+}
+
+func TestClaimBead(t *testing.T) {
+	held := &fakeSlingBeads{status: beads.StatusHooked, assignee: "beads/polecats/Nux"}
+
+	err := claimBead(held, "gt-abc", "gastown/polecats/Toast", false)
+	if !errors.Is(err, ErrBeadClaimed) {
+		t.Fatalf("claimBead() = %v, want ErrBeadClaimed", err)
+	}
+	if held.assignee != "beads/polecats/Nux" {
+		t.Errorf("losing claim changed assignee to %q", held.assignee)
+	}
+
+	// --force re-slings over the existing claim.
+	if err := claimBead(held, "gt-abc", "gastown/polecats/Toast", true); err != nil {
+		t.Fatalf("claimBead(force) = %v", err)
+	}
+	if held.assignee != "gastown/polecats/Toast" {
+		t.Errorf("forced claim assignee = %q", held.assignee)
+	}
+}
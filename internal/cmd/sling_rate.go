@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"time"
+
+	"github.com/steveyegge/gastown/internal/beads"
+)
+
+// spawnRateConfigKey is the town beads config key capping polecat spawns per
+// minute in a batch sling. Unset or 0 means spawns are not paced.
+const spawnRateConfigKey = "sling.spawn_rate"
+
+// spawnRate resolves the batch sling spawn rate (spawns per minute). An
+// explicit --spawn-rate wins; otherwise the town's sling.spawn_rate applies.
+func spawnRate(townRoot string, flagRate int, flagChanged bool) int {
+	if flagChanged {
+		return flagRate
+	}
+	// A malformed value falls back to unpaced
+	rate, _ := beads.New(townRoot).GetConfigInt(spawnRateConfigKey, 0)
+	return rate
+}
+
+// spawnLimiter paces polecat spawns: a token bucket holding one token that
+// refills every minute/perMinute. It caps how fast spawns start, not how many
+// run at once (that is the rig's capacity). A nil limiter never waits.
+type spawnLimiter struct {
+	interval time.Duration
+	next     time.Time // When the bucket next holds a token
+	now      func() time.Time
+	sleep    func(time.Duration)
+}
+
+// newSpawnLimiter returns a limiter allowing perMinute spawns per minute, or
+// nil if perMinute is not positive.
+func newSpawnLimiter(perMinute int) *spawnLimiter {
+	if perMinute <= 0 {
+		return nil
+	}
+	return &spawnLimiter{
+		interval: time.Minute / time.Duration(perMinute),
+		now:      time.Now,
+		sleep:    time.Sleep,
+	}
+}
+
+// Wait blocks until a spawn may start and takes the token.
+func (l *spawnLimiter) Wait() {
+	if l == nil {
+		return
+	}
+	current := l.now()
+	if current.Before(l.next) {
+		l.sleep(l.next.Sub(current))
+		current = l.next
+	}
+	l.next = current.Add(l.interval)
+}
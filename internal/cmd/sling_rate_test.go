@@ -0,0 +1,39 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSpawnLimiterSpacesSpawns(t *testing.T) {
+	start := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	clock := start
+	l := newSpawnLimiter(4) // one spawn every 15s
+	l.now = func() time.Time { return clock }
+	l.sleep = func(d time.Duration) { clock = clock.Add(d) }
+
+	var spawns []time.Duration
+	for i := 0; i < 3; i++ {
+		l.Wait()
+		spawns = append(spawns, clock.Sub(start))
+	}
+	// A slow spawn uses up the wait: the next one may start right away
+	clock = clock.Add(20 * time.Second)
+	l.Wait()
+	spawns = append(spawns, clock.Sub(start))
+
+	want := []time.Duration{0, 15 * time.Second, 30 * time.Second, 50 * time.Second}
+	for i := range want {
+		if spawns[i] != want[i] {
+			t.Fatalf("spawn offsets = %v, want %v", spawns, want)
+		}
+	}
+}
+
+func TestSpawnLimiterUnpaced(t *testing.T) {
+	if l := newSpawnLimiter(0); l != nil {
+		t.Fatalf("newSpawnLimiter(0) = %+v, want nil", l)
+	}
+	var l *spawnLimiter
+	l.Wait() // must not block or panic
+}
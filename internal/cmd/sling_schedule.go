@@ -0,0 +1,167 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/config"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var slingTickCmd = &cobra.Command{
+	Use:   "tick",
+	Short: "Dispatch scheduled slings that are due",
+	Long: `Dispatch beads scheduled with gt sling --at whose start time has passed.
+
+Each due bead is slung to the target recorded when it was scheduled. Beads
+that are not yet due are left alone; a failed dispatch stays scheduled and
+is retried on the next tick. Run it from cron or a patrol loop.
+
+Examples:
+  gt sling gt-abc gastown --at 2h                    # Schedule for two hours from now
+  gt sling gt-abc gastown --at 2026-01-02T22:00:00Z  # Schedule for a fixed time
+  gt sling tick                                      # Dispatch whatever is due`,
+	Args: cobra.NoArgs,
+	RunE: runSlingTick,
+}
+
+func init() {
+	slingCmd.AddCommand(slingTickCmd)
+}
+
+// parseSlingAt parses a --at value: an RFC3339 timestamp or a duration from
+// now (e.g. "90m"). The start time must be in the future.
+func parseSlingAt(value string, now time.Time) (time.Time, error) {
+	at, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		d, derr := time.ParseDuration(value)
+		if derr != nil {
+			return time.Time{}, fmt.Errorf("invalid --at %q: want an RFC3339 time or a duration", value)
+		}
+		at = now.Add(d)
+	}
+	if !at.After(now) {
+		return time.Time{}, fmt.Errorf("invalid --at %q: start time is not in the future", value)
+	}
+	return at, nil
+}
+
+// scheduleSlings records beadIDs for dispatch to target no earlier than the
+// --at time instead of slinging them now. gt sling tick dispatches them.
+func scheduleSlings(townRoot string, beadIDs []string, target string) error {
+	if target == "" {
+		return slingUserError(fmt.Errorf("--at needs an explicit target (the tick that dispatches has no \"self\")"))
+	}
+	at, err := parseSlingAt(slingAt, time.Now())
+	if err != nil {
+		return slingUserError(err)
+	}
+	when := at.UTC().Format(time.RFC3339)
+
+	for _, beadID := range beadIDs {
+		if slingDryRun {
+			fmt.Printf("Would schedule %s → %s at %s\n", beadID, target, when)
+			continue
+		}
+		if err := beadsForBead(townRoot, beadID, "").Schedule(beadID, target, at); err != nil {
+			return fmt.Errorf("scheduling %s: %w", beadID, err)
+		}
+		fmt.Printf("%s Scheduled %s → %s at %s\n", style.Bold.Render("✓"), beadID, target, when)
+	}
+	if !slingDryRun {
+		fmt.Printf("  Dispatched by: gt sling tick\n")
+	}
+	return nil
+}
+
+// scheduledBeads is the subset of *beads.Beads gt sling tick uses.
+type scheduledBeads interface {
+	DueScheduled() ([]*beads.Issue, error)
+	Unschedule(issue *beads.Issue) error
+}
+
+// dispatchScheduledSling slings one due bead. It is a variable so tests can
+// observe dispatches without spawning anything.
+var dispatchScheduledSling = func(beadID, target string) error {
+	return runSling(nil, []string{beadID, target})
+}
+
+// tickScheduled dispatches the due beads in one database, unscheduling each
+// after it is slung. Failed dispatches stay scheduled for the next tick.
+func tickScheduled(b scheduledBeads) (dispatched []string, err error) {
+	due, err := b.DueScheduled()
+	if err != nil {
+		return nil, err
+	}
+
+	var failed []string
+	for _, issue := range due {
+		var target string
+		if fields := beads.ParseAttachmentFields(issue); fields != nil {
+			target = fields.ScheduledTarget
+		}
+		if target == "" {
+			failed = append(failed, fmt.Sprintf("%s: no scheduled target", issue.ID))
+			continue
+		}
+		if err := dispatchScheduledSling(issue.ID, target); err != nil {
+			failed = append(failed, fmt.Sprintf("%s: %v", issue.ID, err))
+			continue
+		}
+		if err := b.Unschedule(issue); err != nil {
+			failed = append(failed, fmt.Sprintf("%s: slung but not unscheduled: %v", issue.ID, err))
+		}
+		dispatched = append(dispatched, issue.ID)
+	}
+
+	if len(failed) > 0 {
+		return dispatched, fmt.Errorf("dispatching scheduled beads: %s", strings.Join(failed, "; "))
+	}
+	return dispatched, nil
+}
+
+func runSlingTick(cmd *cobra.Command, args []string) error {
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("finding town root: %w", err)
+	}
+
+	// Scheduled beads live wherever the bead lives: the town and every rig.
+	dirs := []string{townRoot}
+	if rigsConfig, err := config.LoadRigsConfig(filepath.Join(townRoot, "mayor", "rigs.json")); err == nil {
+		rigNames := make([]string, 0, len(rigsConfig.Rigs))
+		for name := range rigsConfig.Rigs {
+			rigNames = append(rigNames, name)
+		}
+		sort.Strings(rigNames)
+		for _, name := range rigNames {
+			dirs = append(dirs, filepath.Join(townRoot, name))
+		}
+	}
+
+	var total int
+	var errs []string
+	for _, dir := range dirs {
+		dispatched, err := tickScheduled(beads.New(dir))
+		total += len(dispatched)
+		if err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+
+	if total == 0 && len(errs) == 0 {
+		fmt.Printf("%s No scheduled slings due\n", style.Dim.Render("○"))
+		return nil
+	}
+	fmt.Printf("%s Dispatched %d scheduled sling(s)\n", style.Bold.Render("✓"), total)
+	if len(errs) > 0 {
+		return fmt.Errorf("%s", strings.Join(errs, "\n"))
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package cmd
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/steveyegge/gastown/internal/beads"
+)
+
+func TestParseSlingAt(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		value   string
+		want    time.Time
+		wantErr bool
+	}{
+		{value: "90m", want: now.Add(90 * time.Minute)},
+		{value: "2026-01-01T22:00:00Z", want: time.Date(2026, 1, 1, 22, 0, 0, 0, time.UTC)},
+		{value: "2026-01-01T11:00:00Z", wantErr: true}, // in the past
+		{value: "-5m", wantErr: true},
+		{value: "tonight", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := parseSlingAt(tt.value, now)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("parseSlingAt(%q) = %v, want error", tt.value, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseSlingAt(%q): %v", tt.value, err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("parseSlingAt(%q) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+// fakeScheduled serves due beads and records unschedules.
+type fakeScheduled struct {
+	due         []*beads.Issue
+	unscheduled []string
+}
+
+func (f *fakeScheduled) DueScheduled() ([]*beads.Issue, error) { return f.due, nil }
+
+func (f *fakeScheduled) Unschedule(issue *beads.Issue) error {
+	f.unscheduled = append(f.unscheduled, issue.ID)
+	return nil
+}
+
+func TestTickScheduled(t *testing.T) {
+	orig := dispatchScheduledSling
+	defer func() { dispatchScheduledSling = orig }()
+	var slung []string
+	dispatchScheduledSling = func(beadID, target string) error {
+		if beadID == "gt-bad" {
+			return errors.New("rig not found")
+		}
+		slung = append(slung, beadID+"→"+target)
+		return nil
+	}
+
+	f := &fakeScheduled{due: []*beads.Issue{
+		{ID: "gt-a", Description: "scheduled_target: gastown"},
+		{ID: "gt-bad", Description: "scheduled_target: nowhere"},
+		{ID: "gt-b", Description: "scheduled_target: gastown/crew/max"},
+	}}
+	dispatched, err := tickScheduled(f)
+	if err == nil || !strings.Contains(err.Error(), "gt-bad") {
+		t.Errorf("err = %v, want failure for gt-bad", err)
+	}
+	if got := strings.Join(dispatched, ","); got != "gt-a,gt-b" {
+		t.Errorf("dispatched = %s, want gt-a,gt-b", got)
+	}
+	if got := strings.Join(slung, ","); got != "gt-a→gastown,gt-b→gastown/crew/max" {
+		t.Errorf("slung = %s", got)
+	}
+	// The failed bead stays scheduled for the next tick
+	if got := strings.Join(f.unscheduled, ","); got != "gt-a,gt-b" {
+		t.Errorf("unscheduled = %s, want gt-a,gt-b", got)
+	}
+}
@@ -9,7 +9,17 @@ import (
 )
 
 // resolveTargetAgent converts a target spec to agent ID, pane, and hook root.
-func resolveTargetAgent(target string) (agentID string, pane string, hookRoot string, err error) {
+// A "<rig>/crew" target resolves to the rig's least-loaded idle crew member.
+func resolveTargetAgent(townRoot, target string) (agentID string, pane string, hookRoot string, err error) {
+	if rigName, ok := crewPoolRig(target); ok {
+		return resolveCrewTarget(townRoot, rigName)
+	}
+	return resolveSessionTarget(target)
+}
+
+// resolveSessionTarget resolves a target naming a specific agent through its
+// tmux session.
+func resolveSessionTarget(target string) (agentID string, pane string, hookRoot string, err error) {
 	// First resolve to session name
 	sessionName, err := resolveRoleToSession(target)
 	if err != nil {
@@ -84,3 +94,33 @@ func resolveSelfTarget() (agentID string, pane string, hookRoot string, err erro
 
 	return agentID, pane, hookRoot, nil
 }
+
+// slingTargetIsRig decides whether a sling target names a rig to spawn a
+// polecat in. A name that is both a rig and an existing bead is reported
+// rather than guessed at; --to-rig (forceRig) and --bead (forceBead) settle it.
+func slingTargetIsRig(target string, isRig, isBead, forceRig, forceBead bool) (bool, error) {
+	switch {
+	case forceRig && forceBead:
+		return false, slingUserError(fmt.Errorf("--to-rig and --bead cannot be used together"))
+	case forceRig:
+		if !isRig {
+			return false, slingUserError(fmt.Errorf("--to-rig: '%s' is not a rig", target))
+		}
+		return true, nil
+	case forceBead:
+		return false, nil
+	case isRig && isBead:
+		return false, slingUserError(fmt.Errorf("'%s' is both a rig and a bead\nUse --to-rig to sling to the rig, or --bead to treat it as a bead", target))
+	default:
+		return isRig, nil
+	}
+}
+
+// resolveSlingRigTarget applies slingTargetIsRig to a target argument with
+// the --to-rig and --bead flags. The bead lookup only runs for rig names.
+func resolveSlingRigTarget(townRoot, target string) (rigName string, asRig bool, err error) {
+	rigName, isRig := IsRigName(townRoot, target)
+	isBead := isRig && verifyBeadExists(townRoot, target) == nil
+	asRig, err = slingTargetIsRig(target, isRig, isBead, slingToRig, slingAsBead)
+	return rigName, asRig, err
+}
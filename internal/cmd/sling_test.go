@@ -224,9 +224,9 @@ func TestSlingFormulaOnBeadRoutesBDCommandsToTargetRig(t *testing.T) {
 	bdScript := `#!/bin/sh
 set -e
 echo "$(pwd)|$*" >> "${BD_LOG}"
-if [ "$1" = "--no-daemon" ]; then
+while [ "$1" = "--no-daemon" ] || [ "$1" = "--allow-stale" ]; do
   shift
-fi
+done
 cmd="$1"
 shift || true
 case "$cmd" in
@@ -334,10 +334,10 @@ exit 0
 			if dir != wantDir {
 				t.Fatalf("bd mol wisp ran in %q, want %q (args: %q)", dir, wantDir, args)
 			}
-		case strings.Contains(args, " mol bond "):
+		case strings.Contains(args, " dep add ") && strings.Contains(args, "--type=tracks"):
 			gotBond = true
 			if dir != wantDir {
-				t.Fatalf("bd mol bond ran in %q, want %q (args: %q)", dir, wantDir, args)
+				t.Fatalf("bd dep add ran in %q, want %q (args: %q)", dir, wantDir, args)
 			}
 		}
 	}
@@ -386,9 +386,9 @@ func TestSlingFormulaOnBeadPassesFeatureAndIssueVars(t *testing.T) {
 	bdScript := `#!/bin/sh
 set -e
 echo "ARGS:$*" >> "${BD_LOG}"
-if [ "$1" = "--no-daemon" ]; then
+while [ "$1" = "--no-daemon" ] || [ "$1" = "--allow-stale" ]; do
   shift
-fi
+done
 cmd="$1"
 shift || true
 case "$cmd" in
@@ -541,18 +541,9 @@ exit 0
 
 	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
 
-	cwd, err := os.Getwd()
-	if err != nil {
-		t.Fatalf("getwd: %v", err)
-	}
-	t.Cleanup(func() { _ = os.Chdir(cwd) })
-	if err := os.Chdir(townRoot); err != nil {
-		t.Fatalf("chdir: %v", err)
-	}
-
 	// EXPECTED: verifyBeadExists should use --no-daemon --allow-stale and succeed
 	beadID := "jv-v599"
-	err = verifyBeadExists(beadID)
+	err := verifyBeadExists(townRoot, beadID)
 	if err != nil {
 		t.Errorf("verifyBeadExists(%q) failed: %v\nExpected --allow-stale to skip sync check", beadID, err)
 	}
@@ -752,9 +743,9 @@ func TestSlingFormulaOnBeadSetsAttachedMolecule(t *testing.T) {
 	bdScript := `#!/bin/sh
 set -e
 echo "$PWD|$*" >> "${BD_LOG}"
-if [ "$1" = "--no-daemon" ]; then
+while [ "$1" = "--no-daemon" ] || [ "$1" = "--allow-stale" ]; do
   shift
-fi
+done
 cmd="$1"
 shift || true
 case "$cmd" in
@@ -836,7 +827,7 @@ exit 0
 		t.Fatalf("read bd log: %v", err)
 	}
 
-	// After bonding (mol bond), there should be an update call that includes
+	// After bonding (a tracks edge), there should be an update call that includes
 	// --description with attached_molecule field. This is what gt hook looks for.
 	logLines := strings.Split(string(logBytes), "\n")
 
@@ -844,7 +835,7 @@ exit 0
 	sawBond := false
 	foundAttachedMolecule := false
 	for _, line := range logLines {
-		if strings.Contains(line, "mol bond") {
+		if strings.Contains(line, "dep add gt-wisp-xyz gt-abc123 --type=tracks") {
 			sawBond = true
 			continue
 		}
@@ -858,12 +849,108 @@ exit 0
 	}
 
 	if !sawBond {
-		t.Fatalf("mol bond command not found in log:\n%s", string(logBytes))
+		t.Fatalf("tracks bond (bd dep add) not found in log:\n%s", string(logBytes))
 	}
 
 	if !foundAttachedMolecule {
-		t.Errorf("after mol bond, expected update with attached_molecule in description\n"+
+		t.Errorf("after bonding, expected update with attached_molecule in description\n"+
 			"This is required for gt hook to recognize the molecule attachment.\n"+
 			"Log output:\n%s", string(logBytes))
 	}
 }
+
+// TestWakeRigAgentsWithoutTmux verifies that wakeRigAgents skips booting and
+// nudging when tmux is unavailable, and reports both patrol agents as unwoken.
+func TestWakeRigAgentsWithoutTmux(t *testing.T) {
+	binDir := t.TempDir()
+	gtLog := filepath.Join(binDir, "gt.log")
+
+	// Fake tmux that is not usable, and a gt that records any invocation.
+	stubs := map[string]string{
+		"tmux": "#!/bin/sh\nexit 1\n",
+		"gt":   "#!/bin/sh\necho \"$@\" >> \"" + gtLog + "\"\n",
+	}
+	for name, script := range stubs {
+		if err := os.WriteFile(filepath.Join(binDir, name), []byte(script), 0755); err != nil {
+			t.Fatalf("write %s stub: %v", name, err)
+		}
+	}
+	t.Setenv("PATH", binDir)
+
+	result := wakeRigAgents("gastown")
+
+	if !result.TmuxUnavailable {
+		t.Error("TmuxUnavailable = false, want true")
+	}
+	want := []string{"gt-gastown-witness", "gt-gastown-refinery"}
+	if strings.Join(result.Unwoken, ",") != strings.Join(want, ",") {
+		t.Errorf("Unwoken = %v, want %v", result.Unwoken, want)
+	}
+	if !strings.Contains(result.Summary(), "tmux unavailable") {
+		t.Errorf("Summary() = %q, should mention tmux", result.Summary())
+	}
+	if _, err := os.Stat(gtLog); err == nil {
+		t.Error("gt rig boot should not run without tmux")
+	}
+}
+
+// TestWakeRigAgentsReportsOnlyFailedNudges verifies that a patrol session
+// that does not exist yet is left to find work on start, while a running
+// session that cannot be nudged is reported.
+func TestWakeRigAgentsReportsOnlyFailedNudges(t *testing.T) {
+	binDir := t.TempDir()
+	// The witness runs but rejects keys; the refinery has no session yet.
+	stubs := map[string]string{
+		"tmux": "#!/bin/sh\ncase \"$*\" in\n  -V) exit 0 ;;\n  *has-session*witness*) exit 0 ;;\n  *has-session*) echo \"can't find session\" >&2; exit 1 ;;\n  *) echo \"send failed\" >&2; exit 1 ;;\nesac\n",
+		"gt":   "#!/bin/sh\nexit 0\n",
+	}
+	for name, script := range stubs {
+		if err := os.WriteFile(filepath.Join(binDir, name), []byte(script), 0755); err != nil {
+			t.Fatalf("write %s stub: %v", name, err)
+		}
+	}
+	t.Setenv("PATH", binDir)
+
+	result := wakeRigAgents("gastown")
+
+	if result.TmuxUnavailable {
+		t.Error("TmuxUnavailable = true, want false")
+	}
+	if want := "gt-gastown-witness"; strings.Join(result.Unwoken, ",") != want {
+		t.Errorf("Unwoken = %v, want [%s]", result.Unwoken, want)
+	}
+}
+
+func TestSlingTargetIsRig(t *testing.T) {
+	tests := []struct {
+		name                            string
+		isRig, isBead, forceRig, forceB bool
+		want                            bool
+		wantErr                         string
+	}{
+		{name: "rig", isRig: true, want: true},
+		{name: "bead or agent", isBead: true, want: false},
+		{name: "ambiguous", isRig: true, isBead: true, wantErr: "both a rig and a bead"},
+		{name: "to-rig settles ambiguity", isRig: true, isBead: true, forceRig: true, want: true},
+		{name: "bead settles ambiguity", isRig: true, isBead: true, forceB: true, want: false},
+		{name: "to-rig on non-rig", isBead: true, forceRig: true, wantErr: "is not a rig"},
+		{name: "both flags", isRig: true, forceRig: true, forceB: true, wantErr: "cannot be used together"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := slingTargetIsRig("gastown", tt.isRig, tt.isBead, tt.forceRig, tt.forceB)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("err = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("asRig = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -62,6 +62,8 @@ type TownStatus struct {
 	Agents   []AgentRuntime `json:"agents"`             // Global agents (Mayor, Deacon)
 	Rigs     []RigStatus    `json:"rigs"`
 	Summary  StatusSum      `json:"summary"`
+
+	BeadsHealth *beads.HealthSummary `json:"beads_health,omitempty"` // Town beads health (omitted with --fast)
 }
 
 // OverseerInfo represents the human operator's identity and status.
@@ -335,6 +337,15 @@ func runStatusOnce(_ *cobra.Command, _ []string) error {
 		status.Agents = discoverGlobalAgents(allSessions, allAgentBeads, allHookBeads, mailRouter, statusFast)
 	}()
 
+	// Aggregate town beads health (daemon, sync, repo) alongside
+	if !statusFast {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			status.BeadsHealth, _ = townBeadsClient.HealthSummary()
+		}()
+	}
+
 	// Process all rigs in parallel
 	rigActiveHooks := make([]int, len(rigs)) // Track hooks per rig for thread safety
 	for i, r := range rigs {
@@ -419,6 +430,27 @@ func outputStatusJSON(status TownStatus) error {
 	return enc.Encode(status)
 }
 
+// renderBeadsHealth prints a one-line beads health widget, listing the
+// sub-checks that are not green.
+func renderBeadsHealth(h *beads.HealthSummary) {
+	var level string
+	switch h.Overall {
+	case beads.HealthRed:
+		level = style.Error.Render("● red")
+	case beads.HealthYellow:
+		level = style.Warning.Render("● yellow")
+	default:
+		level = style.Success.Render("● green")
+	}
+	fmt.Printf("🩺 %s %s\n", style.Bold.Render("Beads:"), level)
+	for _, c := range h.Checks {
+		if c.Level != beads.HealthGreen {
+			fmt.Printf("   %s %s\n", style.Dim.Render(c.Name+":"), c.Message)
+		}
+	}
+	fmt.Println()
+}
+
 func outputStatusText(status TownStatus) error {
 	// Header
 	fmt.Printf("%s %s\n", style.Bold.Render("Town:"), status.Name)
@@ -439,6 +471,10 @@ func outputStatusText(status TownStatus) error {
 		fmt.Println()
 	}
 
+	if status.BeadsHealth != nil {
+		renderBeadsHealth(status.BeadsHealth)
+	}
+
 	// Role icons - uses centralized emojis from constants package
 	roleIcons := map[string]string{
 		constants.RoleMayor:    constants.EmojiMayor,
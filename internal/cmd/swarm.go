@@ -3,6 +3,7 @@ package cmd
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"os/exec"
@@ -33,6 +34,8 @@ var (
 	swarmListStatus string
 	swarmListJSON   bool
 	swarmTarget     string
+
+	swarmSkipValidation bool
 )
 
 var swarmCmd = &cobra.Command{
@@ -138,14 +141,22 @@ Finds the first unassigned task in the epic's ready front and spawns a
 fresh polecat to work on it. Self-cleaning model: polecats are always
 fresh - there are no idle polecats to reuse.
 
+With --all, every unassigned ready task is slung to its own fresh polecat
+(like 'gt sling <bead>... <rig>'). Rig capacity is respected: once the rig
+is full, the remaining tasks are skipped for a later dispatch.
+
 Examples:
   gt swarm dispatch gt-abc         # Dispatch next task from epic gt-abc
-  gt swarm dispatch gt-abc --rig greenplace  # Dispatch in specific rig`,
+  gt swarm dispatch gt-abc --rig greenplace  # Dispatch in specific rig
+  gt swarm dispatch gt-abc --all   # Fan out all ready tasks`,
 	Args: cobra.ExactArgs(1),
 	RunE: runSwarmDispatch,
 }
 
-var swarmDispatchRig string
+var (
+	swarmDispatchRig string
+	swarmDispatchAll bool
+)
 
 func init() {
 	// Create flags
@@ -153,6 +164,7 @@ func init() {
 	swarmCreateCmd.Flags().StringSliceVar(&swarmWorkers, "worker", nil, "Polecat names to assign (repeatable)")
 	swarmCreateCmd.Flags().BoolVar(&swarmStart, "start", false, "Start swarm immediately after creation")
 	swarmCreateCmd.Flags().StringVar(&swarmTarget, "target", "main", "Target branch for landing")
+	swarmCreateCmd.Flags().BoolVar(&swarmSkipValidation, "skip-validation", false, "Swarm a pre-created epic even if it has structural problems")
 	_ = swarmCreateCmd.MarkFlagRequired("epic") // cobra flags: error only at runtime if missing
 
 	// Status flags
@@ -164,6 +176,7 @@ func init() {
 
 	// Dispatch flags
 	swarmDispatchCmd.Flags().StringVar(&swarmDispatchRig, "rig", "", "Rig to dispatch in (auto-detected from epic if not specified)")
+	swarmDispatchCmd.Flags().BoolVar(&swarmDispatchAll, "all", false, "Dispatch every unassigned ready task, each to its own polecat")
 
 	// Add subcommands
 	swarmCmd.AddCommand(swarmCreateCmd)
@@ -237,7 +250,14 @@ func runSwarmCreate(cmd *cobra.Command, args []string) error {
 	beadsPath := r.BeadsPath()
 	checkCmd := exec.Command("bd", "show", swarmEpic, "--json")
 	checkCmd.Dir = beadsPath
-	if err := checkCmd.Run(); err != nil {
+	if err := checkCmd.Run(); err == nil {
+		// Pre-created epic: check its structure before swarming it
+		if !swarmSkipValidation {
+			if err := validateSwarmEpic(swarm.NewManager(r), swarmEpic); err != nil {
+				return err
+			}
+		}
+	} else {
 		// Epic doesn't exist, create it as a swarm molecule
 		createArgs := []string{
 			"create",
@@ -312,6 +332,25 @@ func runSwarmCreate(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// validateSwarmEpic reports structural problems in a pre-created epic and
+// refuses to swarm it until they are fixed (or --skip-validation is given).
+func validateSwarmEpic(m *swarm.Manager, epicID string) error {
+	report, err := m.Validate(epicID)
+	if err != nil {
+		return fmt.Errorf("validating epic: %w", err)
+	}
+	if report.Valid() {
+		return nil
+	}
+
+	fmt.Printf("%s Epic %s has %d problem(s):\n", style.Warning.Render("⚠"), epicID, len(report.Problems))
+	for _, p := range report.Problems {
+		fmt.Printf("  %s: %s\n", p.IssueID, p.Detail)
+	}
+	fmt.Println()
+	return fmt.Errorf("epic %s is not ready to swarm (fix the problems above or use --skip-validation)", epicID)
+}
+
 func runSwarmStart(cmd *cobra.Command, args []string) error {
 	swarmID := args[0]
 
@@ -459,6 +498,10 @@ func runSwarmDispatch(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
+	if swarmDispatchAll {
+		return dispatchSwarmFanOut(unassigned, foundRig.Name, townRoot)
+	}
+
 	// Self-cleaning model: Always spawn fresh polecats for work.
 	// There are no "idle" polecats - polecats self-nuke when done.
 	// Just sling to the rig and let gt sling spawn a fresh polecat.
@@ -486,6 +529,32 @@ func runSwarmDispatch(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// dispatchSwarmFanOut slings each task to its own fresh polecat through the
+// batch sling path. Fail-fast is forced on so that hitting the rig's polecat
+// capacity skips the remaining tasks instead of failing each one.
+func dispatchSwarmFanOut(tasks []struct {
+	ID    string
+	Title string
+}, rigName, townRoot string) error {
+	beadIDs := make([]string, len(tasks))
+	for i, task := range tasks {
+		beadIDs[i] = task.ID
+	}
+
+	prevFailFast := slingFailFast
+	slingFailFast = true
+	defer func() { slingFailFast = prevFailFast }()
+
+	fmt.Printf("Dispatching %d ready tasks to fresh polecats in %s...\n", len(beadIDs), rigName)
+	err := runBatchSling(beadIDs, rigName, filepath.Join(townRoot, ".beads"))
+	if errors.Is(err, ErrRigAtCapacity) {
+		// A full rig is expected mid-swarm; skipped tasks go out on the next dispatch.
+		fmt.Printf("\n%s Rig %s is at capacity; run 'gt swarm dispatch' again as polecats finish\n", style.Dim.Render("○"), rigName)
+		return nil
+	}
+	return err
+}
+
 // spawnSwarmWorkersFromBeads spawns sessions for swarm workers using beads task list.
 func spawnSwarmWorkersFromBeads(r *rig.Rig, townRoot string, swarmID string, workers []string, tasks []struct {
 	ID    string `json:"id"`
@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestDispatchSwarmFanOutStopsAtCapacity(t *testing.T) {
+	spawned := stubBatchSling(t, nil)
+	spawnPolecatForBatch = func(rigName string, opts SlingSpawnOptions) (*SpawnedPolecatInfo, error) {
+		*spawned = append(*spawned, opts.HookBead)
+		if len(*spawned) > 1 {
+			return nil, fmt.Errorf("rig %s: %w", rigName, ErrRigAtCapacity)
+		}
+		// Fails after the spawn is recorded, so the fake never needs a real polecat.
+		return nil, fmt.Errorf("polecat 'Toast' has uncommitted work")
+	}
+
+	tasks := []struct {
+		ID    string
+		Title string
+	}{{"gt-a", "A"}, {"gt-b", "B"}, {"gt-c", "C"}}
+
+	if err := dispatchSwarmFanOut(tasks, "gastown", t.TempDir()); err != nil {
+		t.Fatalf("dispatchSwarmFanOut() = %v, want nil when the rig fills up", err)
+	}
+	if got := fmt.Sprint(*spawned); got != "[gt-a gt-b]" {
+		t.Errorf("spawned = %s, want [gt-a gt-b] (gt-c skipped at capacity)", got)
+	}
+	if slingFailFast {
+		t.Error("dispatchSwarmFanOut left --fail-fast enabled")
+	}
+}
+
+func TestDispatchSwarmFanOutSlingsEveryTask(t *testing.T) {
+	spawned := stubBatchSling(t, fmt.Errorf("polecat 'Toast' has uncommitted work"))
+
+	tasks := []struct {
+		ID    string
+		Title string
+	}{{"gt-a", "A"}, {"gt-b", "B"}, {"gt-c", "C"}}
+
+	if err := dispatchSwarmFanOut(tasks, "gastown", t.TempDir()); err != nil {
+		t.Fatalf("dispatchSwarmFanOut() = %v", err)
+	}
+	if len(*spawned) != len(tasks) {
+		t.Errorf("spawned = %v, want one polecat per task", *spawned)
+	}
+}
@@ -71,11 +71,16 @@ func runUnsling(cmd *cobra.Command, args []string) error {
 		targetAgent = args[1]
 	}
 
+	// Find town root and rig path for agent beads
+	townRoot, err := workspace.FindFromCwd()
+	if err != nil {
+		return fmt.Errorf("finding town root: %w", err)
+	}
+
 	// Resolve target agent (default: self)
 	var agentID string
-	var err error
 	if targetAgent != "" {
-		agentID, _, _, err = resolveTargetAgent(targetAgent)
+		agentID, _, _, err = resolveTargetAgent(townRoot, targetAgent)
 		if err != nil {
 			return fmt.Errorf("resolving target agent: %w", err)
 		}
@@ -86,12 +91,6 @@ func runUnsling(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	// Find town root and rig path for agent beads
-	townRoot, err := workspace.FindFromCwd()
-	if err != nil {
-		return fmt.Errorf("finding town root: %w", err)
-	}
-
 	// Extract rig name from agent ID (e.g., "gastown/crew/joe" -> "gastown")
 	// For town-level agents like "mayor/", use town root
 	rigName := strings.Split(agentID, "/")[0]
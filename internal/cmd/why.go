@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/style"
+	"github.com/steveyegge/gastown/internal/workspace"
+)
+
+var whyCmd = &cobra.Command{
+	Use:     "why <bead-id>",
+	GroupID: GroupWork,
+	Short:   "Explain why a bead is not ready",
+	Long: `Show the chains of open blockers keeping a bead from being ready.
+
+Each line follows blocking dependencies from a direct blocker down to a
+root blocker - an open bead with no open blockers of its own. Closing the
+root blockers is what unblocks the chain.
+
+Examples:
+  gt why gt-abc`,
+	Args: cobra.ExactArgs(1),
+	RunE: runWhy,
+}
+
+func init() {
+	rootCmd.AddCommand(whyCmd)
+}
+
+func runWhy(cmd *cobra.Command, args []string) error {
+	beadID := args[0]
+
+	townRoot, err := workspace.FindFromCwdOrError()
+	if err != nil {
+		return fmt.Errorf("not in a Gas Town workspace: %w", err)
+	}
+	cwd, _ := os.Getwd()
+
+	paths, err := beadsForBead(townRoot, beadID, cwd).BlockingChain(beadID)
+	if err != nil {
+		return fmt.Errorf("checking blockers for %s: %w", beadID, err)
+	}
+
+	if len(paths) == 0 {
+		fmt.Printf("%s %s has no open blockers\n", style.Bold.Render("✓"), beadID)
+		return nil
+	}
+
+	fmt.Printf("%s %s is blocked:\n", style.Bold.Render("⊘"), beadID)
+	for _, path := range paths {
+		fmt.Printf("  blocked by %s %s\n", strings.Join(path, " → "), style.Dim.Render("(open)"))
+	}
+	return nil
+}
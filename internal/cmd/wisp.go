@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/steveyegge/gastown/internal/beads"
+	"github.com/steveyegge/gastown/internal/style"
+)
+
+var (
+	wispGCOlderThan time.Duration
+	wispGCDryRun    bool
+)
+
+var wispCmd = &cobra.Command{
+	Use:     "wisp",
+	GroupID: GroupWork,
+	Short:   "Manage wisps (ephemeral beads)",
+	RunE:    requireSubcommand,
+}
+
+var wispGCCmd = &cobra.Command{
+	Use:   "gc",
+	Short: "Delete closed wisps",
+	Long: `Delete closed wisps from the current beads database.
+
+Wisps are ephemeral beads used for patrol cycles and molecule scaffolding.
+Once closed they are no longer needed. Use --older-than to only reap wisps
+closed some time ago, and --dry-run to preview what would be deleted.
+
+Beads created with a TTL that has elapsed are expired (closed) first, so
+they are reaped in the same pass when no age threshold is set.
+
+Examples:
+  gt wisp gc                        # Delete all closed wisps
+  gt wisp gc --older-than 24h       # Only wisps closed over a day ago
+  gt wisp gc --dry-run              # Show what would be deleted`,
+	RunE: runWispGC,
+}
+
+func init() {
+	wispGCCmd.Flags().DurationVar(&wispGCOlderThan, "older-than", 0, "Only delete wisps closed at least this long ago")
+	wispGCCmd.Flags().BoolVarP(&wispGCDryRun, "dry-run", "n", false, "Show what would be deleted without deleting")
+
+	wispCmd.AddCommand(wispGCCmd)
+	rootCmd.AddCommand(wispCmd)
+}
+
+func runWispGC(cmd *cobra.Command, args []string) error {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("getting working directory: %w", err)
+	}
+
+	bd := beads.New(cwd)
+
+	if !wispGCDryRun {
+		expired, err := bd.ExpireEphemeral()
+		if err != nil {
+			fmt.Printf("%s Could not expire TTL beads: %v\n", style.Dim.Render("Warning:"), err)
+		}
+		for _, id := range expired {
+			fmt.Printf("%s Expired %s (ttl)\n", style.Dim.Render("○"), id)
+		}
+	}
+
+	ids, err := bd.WispGC(beads.WispGCOptions{
+		OlderThan: wispGCOlderThan,
+		DryRun:    wispGCDryRun,
+	})
+	if err != nil && len(ids) == 0 {
+		return fmt.Errorf("wisp gc: %w", err)
+	}
+
+	if len(ids) == 0 {
+		fmt.Printf("%s No closed wisps to collect\n", style.Dim.Render("○"))
+		return nil
+	}
+
+	verb := "Deleted"
+	if wispGCDryRun {
+		verb = "Would delete"
+	}
+	for _, id := range ids {
+		fmt.Printf("  %s\n", id)
+	}
+	fmt.Printf("%s %s %d wisp(s)\n", style.Bold.Render("✓"), verb, len(ids))
+
+	return err
+}
@@ -500,6 +500,11 @@ func validateAccountsConfig(c *AccountsConfig) error {
 			return fmt.Errorf("%w: default account '%s' not found in accounts", ErrMissingField, c.Default)
 		}
 	}
+	for _, handle := range c.Pool {
+		if _, ok := c.Accounts[handle]; !ok {
+			return fmt.Errorf("%w: pool account '%s' not found in accounts", ErrMissingField, handle)
+		}
+	}
 	// Validate each account has required fields
 	for handle, acct := range c.Accounts {
 		if acct.ConfigDir == "" {
@@ -525,6 +530,18 @@ func (c *AccountsConfig) GetAccount(handle string) *Account {
 	return nil
 }
 
+// HandleForConfigDir returns the handle of the account whose config
+// directory is dir, or "" if no account uses it.
+func (c *AccountsConfig) HandleForConfigDir(dir string) string {
+	dir = filepath.Clean(expandPath(dir))
+	for handle, acct := range c.Accounts {
+		if filepath.Clean(expandPath(acct.ConfigDir)) == dir {
+			return handle
+		}
+	}
+	return ""
+}
+
 // GetDefaultAccount returns the default account, or nil if not set.
 func (c *AccountsConfig) GetDefaultAccount() *Account {
 	if c.Default == "" {
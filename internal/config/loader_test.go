@@ -526,6 +526,17 @@ func TestAccountsConfigValidation(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "pool refers to nonexistent account",
+			config: &AccountsConfig{
+				Version: 1,
+				Accounts: map[string]Account{
+					"test": {Email: "test@example.com", ConfigDir: "~/.claude-accounts/test"},
+				},
+				Pool: []string{"test", "nonexistent"},
+			},
+			wantErr: true,
+		},
 		{
 			name: "account missing config_dir",
 			config: &AccountsConfig{
@@ -714,6 +714,10 @@ type AccountsConfig struct {
 	Version  int                `json:"version"`  // schema version
 	Accounts map[string]Account `json:"accounts"` // handle -> account details
 	Default  string             `json:"default"`  // default account handle
+
+	// Pool lists account handles that polecat spawns without an explicit
+	// account are balanced across. Empty means spawns use the default.
+	Pool []string `json:"pool,omitempty"`
 }
 
 // Account represents a single Claude Code account.
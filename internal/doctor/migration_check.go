@@ -0,0 +1,88 @@
+package doctor
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/steveyegge/gastown/internal/beads"
+)
+
+// MigrationCheck warns when a beads database has pending schema migrations.
+// It only inspects status; migrations are run by 'bd migrate'.
+type MigrationCheck struct {
+	BaseCheck
+}
+
+// NewMigrationCheck creates a new beads migration status check.
+func NewMigrationCheck() *MigrationCheck {
+	return &MigrationCheck{
+		BaseCheck: BaseCheck{
+			CheckName:        "beads-migrations",
+			CheckDescription: "Check for pending beads schema migrations",
+			CheckCategory:    CategoryInfrastructure,
+		},
+	}
+}
+
+// Run checks the town and rig beads databases for pending migrations.
+func (c *MigrationCheck) Run(ctx *CheckContext) *CheckResult {
+	locations := map[string]string{"town": ctx.TownRoot}
+	if rigs, err := discoverRigs(ctx.TownRoot); err == nil {
+		for _, rigName := range rigs {
+			locations[rigName] = filepath.Join(ctx.TownRoot, rigName)
+		}
+	}
+
+	names := make([]string, 0, len(locations))
+	for name := range locations {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var details, failures []string
+	for _, name := range names {
+		dir := locations[name]
+		if _, err := os.Stat(beads.ResolveBeadsDir(dir)); err != nil {
+			continue
+		}
+		status, err := beads.New(dir).MigrationStatus()
+		if errors.Is(err, beads.ErrUnsupported) {
+			// bd too old to report migration status; nothing to check
+			continue
+		}
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("%s: %v", name, err))
+			continue
+		}
+		if status.NeedsMigration {
+			details = append(details, fmt.Sprintf("%s: schema %s, latest %s", name, status.CurrentVersion, status.LatestVersion))
+		}
+	}
+
+	if len(details) > 0 {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusWarning,
+			Message: fmt.Sprintf("%d beads database(s) have pending migrations", len(details)),
+			Details: append(details, failures...),
+			FixHint: "Run 'bd migrate' in each listed location",
+		}
+	}
+	if len(failures) > 0 {
+		return &CheckResult{
+			Name:    c.Name(),
+			Status:  StatusWarning,
+			Message: fmt.Sprintf("Could not read migration status for %d beads database(s)", len(failures)),
+			Details: failures,
+		}
+	}
+
+	return &CheckResult{
+		Name:    c.Name(),
+		Status:  StatusOK,
+		Message: "No pending beads migrations",
+	}
+}
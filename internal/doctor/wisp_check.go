@@ -128,10 +128,20 @@ func (c *WispGCCheck) countAbandonedWisps(rigPath string) int {
 	return count
 }
 
-// Fix runs bd mol wisp gc in each rig with abandoned wisps.
+// Fix expires TTL beads in every rig, then runs bd mol wisp gc in each rig
+// with abandoned wisps.
 func (c *WispGCCheck) Fix(ctx *CheckContext) error {
 	var lastErr error
 
+	if rigs, err := discoverRigs(ctx.TownRoot); err == nil {
+		for _, rigName := range rigs {
+			rigPath := filepath.Join(ctx.TownRoot, rigName)
+			if _, err := beads.New(rigPath).ExpireEphemeral(); err != nil {
+				lastErr = fmt.Errorf("%s: %v", rigName, err)
+			}
+		}
+	}
+
 	for rigName := range c.abandonedRigs {
 		rigPath := filepath.Join(ctx.TownRoot, rigName)
 
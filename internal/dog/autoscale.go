@@ -0,0 +1,150 @@
+package dog
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// AutoscalePolicy bounds the kennel and says when to grow or shrink it.
+type AutoscalePolicy struct {
+	Min          int           // Dogs kept even when the queue is empty
+	Max          int           // Upper bound on kennel size
+	ScaleUpDepth int           // Queue depth above which a dog is added
+	IdleFor      time.Duration // How long a dog must be idle before removal
+}
+
+// Validate checks that the policy bounds make sense.
+func (p AutoscalePolicy) Validate() error {
+	if p.Min < 0 {
+		return fmt.Errorf("min %d is negative", p.Min)
+	}
+	if p.Max < p.Min {
+		return fmt.Errorf("max %d is below min %d", p.Max, p.Min)
+	}
+	if p.ScaleUpDepth < 0 {
+		return fmt.Errorf("scale-up depth %d is negative", p.ScaleUpDepth)
+	}
+	return nil
+}
+
+// AutoscaleResult reports the dogs added and removed by one scaling step.
+type AutoscaleResult struct {
+	Depth   int      // Queue depth the decision was based on
+	Added   []string // Dogs added to the kennel
+	Removed []string // Idle dogs removed from the kennel
+}
+
+// Pool is the kennel operations autoscaling uses. *Manager implements it.
+type Pool interface {
+	List() ([]*Dog, error)
+	Add(name string) (*Dog, error)
+	Remove(name string) error
+	LockDispatch() (func(), error)
+}
+
+// Autoscale runs one scaling step against the kennel. queueDepth reports how
+// much work is waiting for a dog; it is supplied by the caller.
+func (m *Manager) Autoscale(policy AutoscalePolicy, queueDepth func() (int, error)) (*AutoscaleResult, error) {
+	return autoscale(m, policy, queueDepth, time.Now())
+}
+
+// autoscale grows the pool to policy.Min, then adds one dog per step while
+// the queue is deeper than policy.ScaleUpDepth and the pool is below
+// policy.Max. When the queue is empty it removes dogs that have been idle for
+// policy.IdleFor, longest idle first, down to policy.Min. Working dogs are
+// never removed: removal runs under the dispatch lock against a fresh
+// listing, so a dog dispatched since the first listing is kept.
+func autoscale(pool Pool, policy AutoscalePolicy, queueDepth func() (int, error), now time.Time) (*AutoscaleResult, error) {
+	if err := policy.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid autoscale policy: %w", err)
+	}
+
+	depth, err := queueDepth()
+	if err != nil {
+		return nil, fmt.Errorf("reading queue depth: %w", err)
+	}
+
+	dogs, err := pool.List()
+	if err != nil {
+		return nil, fmt.Errorf("listing dogs: %w", err)
+	}
+
+	result := &AutoscaleResult{Depth: depth}
+
+	want := len(dogs)
+	if want < policy.Min {
+		want = policy.Min
+	}
+	if depth > policy.ScaleUpDepth && len(dogs) >= policy.Min && want < policy.Max {
+		want++
+	}
+	for len(dogs) < want {
+		name := NewDogName(dogs)
+		d, err := pool.Add(name)
+		if err != nil {
+			return result, fmt.Errorf("adding dog %s: %w", name, err)
+		}
+		dogs = append(dogs, d)
+		result.Added = append(result.Added, name)
+	}
+	if len(result.Added) > 0 || depth > 0 {
+		return result, nil
+	}
+
+	unlock, err := pool.LockDispatch()
+	if err != nil {
+		return result, err
+	}
+	defer unlock()
+	dogs, err = pool.List()
+	if err != nil {
+		return result, fmt.Errorf("listing dogs: %w", err)
+	}
+
+	var idle []*Dog
+	for _, d := range dogs {
+		if d.State == StateIdle && now.Sub(d.LastActive) >= policy.IdleFor {
+			idle = append(idle, d)
+		}
+	}
+	sort.SliceStable(idle, func(i, j int) bool { return idle[i].LastActive.Before(idle[j].LastActive) })
+
+	size := len(dogs)
+	for _, d := range idle {
+		if size <= policy.Min {
+			break
+		}
+		if err := pool.Remove(d.Name); err != nil {
+			return result, fmt.Errorf("removing dog %s: %w", d.Name, err)
+		}
+		size--
+		result.Removed = append(result.Removed, d.Name)
+	}
+	return result, nil
+}
+
+// dogNames are handed out in order to new dogs; numbered names follow.
+var dogNames = []string{"alpha", "bravo", "charlie", "delta", "echo", "foxtrot", "golf", "hotel"}
+
+// NewDogName returns a name not used by any of dogs.
+func NewDogName(dogs []*Dog) string {
+	existing := make(map[string]bool)
+	for _, d := range dogs {
+		existing[d.Name] = true
+	}
+
+	for _, name := range dogNames {
+		if !existing[name] {
+			return name
+		}
+	}
+
+	// Fallback: numbered dogs
+	for i := 1; ; i++ {
+		name := fmt.Sprintf("dog%d", i)
+		if !existing[name] {
+			return name
+		}
+	}
+}
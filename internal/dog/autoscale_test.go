@@ -0,0 +1,186 @@
+package dog
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+)
+
+// fakePool is an in-memory kennel.
+type fakePool struct {
+	dogs   map[string]*Dog
+	now    time.Time
+	onLock func() // runs as the dispatch lock is taken, to model a racing dispatch
+}
+
+func newFakePool(now time.Time) *fakePool {
+	return &fakePool{dogs: map[string]*Dog{}, now: now}
+}
+
+func (p *fakePool) List() ([]*Dog, error) {
+	var dogs []*Dog
+	for _, d := range p.dogs {
+		dogs = append(dogs, d)
+	}
+	sort.Slice(dogs, func(i, j int) bool { return dogs[i].Name < dogs[j].Name })
+	return dogs, nil
+}
+
+func (p *fakePool) Add(name string) (*Dog, error) {
+	if _, ok := p.dogs[name]; ok {
+		return nil, ErrDogExists
+	}
+	d := &Dog{Name: name, State: StateIdle, LastActive: p.now}
+	p.dogs[name] = d
+	return d, nil
+}
+
+func (p *fakePool) Remove(name string) error {
+	if _, ok := p.dogs[name]; !ok {
+		return ErrDogNotFound
+	}
+	delete(p.dogs, name)
+	return nil
+}
+
+func (p *fakePool) LockDispatch() (func(), error) {
+	if p.onLock != nil {
+		p.onLock()
+	}
+	return func() {}, nil
+}
+
+func depth(n int) func() (int, error) {
+	return func() (int, error) { return n, nil }
+}
+
+func TestAutoscaleDeepQueueScalesUpToMax(t *testing.T) {
+	start := time.Date(2026, 1, 2, 15, 0, 0, 0, time.UTC)
+	pool := newFakePool(start)
+	policy := AutoscalePolicy{Min: 1, Max: 3, ScaleUpDepth: 2, IdleFor: 10 * time.Minute}
+
+	var added []string
+	for step := 0; step < 5; step++ {
+		result, err := autoscale(pool, policy, depth(10), start.Add(time.Duration(step)*time.Minute))
+		if err != nil {
+			t.Fatalf("step %d: %v", step, err)
+		}
+		added = append(added, result.Added...)
+	}
+
+	if want := []string{"alpha", "bravo", "charlie"}; !reflect.DeepEqual(added, want) {
+		t.Errorf("added = %v, want %v", added, want)
+	}
+	if len(pool.dogs) != policy.Max {
+		t.Errorf("pool size = %d, want max %d", len(pool.dogs), policy.Max)
+	}
+}
+
+func TestAutoscaleShallowQueueHoldsSize(t *testing.T) {
+	start := time.Date(2026, 1, 2, 15, 0, 0, 0, time.UTC)
+	pool := newFakePool(start)
+	_, _ = pool.Add("alpha")
+	policy := AutoscalePolicy{Min: 1, Max: 3, ScaleUpDepth: 2, IdleFor: 10 * time.Minute}
+
+	result, err := autoscale(pool, policy, depth(2), start.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("autoscale: %v", err)
+	}
+	if len(result.Added) != 0 || len(result.Removed) != 0 {
+		t.Errorf("result = %+v, want no change at the threshold", result)
+	}
+}
+
+func TestAutoscaleDrainedQueueScalesDownToMin(t *testing.T) {
+	start := time.Date(2026, 1, 2, 15, 0, 0, 0, time.UTC)
+	pool := newFakePool(start)
+	for _, name := range []string{"alpha", "bravo", "charlie", "delta"} {
+		_, _ = pool.Add(name)
+	}
+	pool.dogs["alpha"].LastActive = start.Add(3 * time.Minute)
+	pool.dogs["charlie"].LastActive = start.Add(time.Minute)
+	pool.dogs["delta"].State = StateWorking
+	policy := AutoscalePolicy{Min: 2, Max: 4, IdleFor: 10 * time.Minute}
+
+	// Not idle long enough yet.
+	result, err := autoscale(pool, policy, depth(0), start.Add(5*time.Minute))
+	if err != nil {
+		t.Fatalf("autoscale: %v", err)
+	}
+	if len(result.Removed) != 0 {
+		t.Fatalf("removed %v before idle timeout", result.Removed)
+	}
+
+	result, err = autoscale(pool, policy, depth(0), start.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("autoscale: %v", err)
+	}
+	// Longest idle first; the working dog is kept.
+	if want := []string{"bravo", "charlie"}; !reflect.DeepEqual(result.Removed, want) {
+		t.Errorf("removed = %v, want %v", result.Removed, want)
+	}
+	if _, ok := pool.dogs["delta"]; !ok {
+		t.Error("working dog delta was removed")
+	}
+	if len(pool.dogs) != policy.Min {
+		t.Errorf("pool size = %d, want min %d", len(pool.dogs), policy.Min)
+	}
+}
+
+func TestAutoscaleKeepsDogDispatchedDuringStep(t *testing.T) {
+	start := time.Date(2026, 1, 2, 15, 0, 0, 0, time.UTC)
+	pool := newFakePool(start)
+	for _, name := range []string{"alpha", "bravo"} {
+		_, _ = pool.Add(name)
+	}
+	// bravo is dispatched after the first listing; the kennel state is
+	// re-read, so the fresh entry replaces the stale one.
+	pool.onLock = func() {
+		pool.dogs["bravo"] = &Dog{Name: "bravo", State: StateWorking, LastActive: start}
+	}
+
+	result, err := autoscale(pool, AutoscalePolicy{Min: 0, Max: 2, IdleFor: time.Minute}, depth(0), start.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("autoscale: %v", err)
+	}
+	if want := []string{"alpha"}; !reflect.DeepEqual(result.Removed, want) {
+		t.Errorf("removed = %v, want %v", result.Removed, want)
+	}
+	if _, ok := pool.dogs["bravo"]; !ok {
+		t.Error("dog dispatched during the step was removed")
+	}
+}
+
+func TestAutoscaleGrowsToMin(t *testing.T) {
+	pool := newFakePool(time.Now())
+	result, err := autoscale(pool, AutoscalePolicy{Min: 2, Max: 4}, depth(0), time.Now())
+	if err != nil {
+		t.Fatalf("autoscale: %v", err)
+	}
+	if want := []string{"alpha", "bravo"}; !reflect.DeepEqual(result.Added, want) {
+		t.Errorf("added = %v, want %v", result.Added, want)
+	}
+}
+
+func TestAutoscaleRejectsInvalidPolicy(t *testing.T) {
+	pool := newFakePool(time.Now())
+	if _, err := autoscale(pool, AutoscalePolicy{Min: 3, Max: 1}, depth(0), time.Now()); err == nil {
+		t.Error("expected error for max below min")
+	}
+}
+
+func TestNewDogName(t *testing.T) {
+	dogs := []*Dog{{Name: "alpha"}, {Name: "charlie"}}
+	if got := NewDogName(dogs); got != "bravo" {
+		t.Errorf("NewDogName = %q, want bravo", got)
+	}
+
+	dogs = nil
+	for _, name := range dogNames {
+		dogs = append(dogs, &Dog{Name: name})
+	}
+	if got := NewDogName(dogs); got != "dog1" {
+		t.Errorf("NewDogName with all names taken = %q, want dog1", got)
+	}
+}
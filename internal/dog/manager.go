@@ -1,6 +1,7 @@
 package dog
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -8,6 +9,7 @@ import (
 	"path/filepath"
 	"time"
 
+	"github.com/gofrs/flock"
 	"github.com/steveyegge/gastown/internal/config"
 	"github.com/steveyegge/gastown/internal/git"
 	"github.com/steveyegge/gastown/internal/rig"
@@ -20,6 +22,12 @@ var (
 	ErrNoRigs      = errors.New("no rigs configured")
 )
 
+// dispatchLockFile is the kennel lock serializing dispatch against removal.
+const dispatchLockFile = ".dispatch.lock"
+
+// dispatchLockTimeout bounds how long LockDispatch waits for the lock.
+const dispatchLockTimeout = 30 * time.Second
+
 // Manager handles dog lifecycle in the kennel.
 type Manager struct {
 	townRoot   string
@@ -284,6 +292,27 @@ func (m *Manager) SetState(name string, state State) error {
 	return m.saveState(name, dogState)
 }
 
+// LockDispatch takes the kennel's dispatch lock. Hold it while picking a dog
+// and assigning it work, and while removing idle dogs, so a dog is never
+// removed as it is being dispatched. Call the returned func to release it.
+func (m *Manager) LockDispatch() (func(), error) {
+	if err := os.MkdirAll(m.kennelPath, 0755); err != nil {
+		return nil, fmt.Errorf("creating kennel: %w", err)
+	}
+
+	lock := flock.New(filepath.Join(m.kennelPath, dispatchLockFile))
+	ctx, cancel := context.WithTimeout(context.Background(), dispatchLockTimeout)
+	defer cancel()
+	locked, err := lock.TryLockContext(ctx, 50*time.Millisecond)
+	if err != nil {
+		return nil, fmt.Errorf("acquiring dispatch lock: %w", err)
+	}
+	if !locked {
+		return nil, errors.New("acquiring dispatch lock: timed out")
+	}
+	return func() { _ = lock.Unlock() }, nil
+}
+
 // AssignWork assigns work to a dog and sets it to working state.
 func (m *Manager) AssignWork(name, work string) error {
 	if !m.exists(name) {
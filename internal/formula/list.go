@@ -0,0 +1,117 @@
+package formula
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"sort"
+	"strings"
+)
+
+// formulaFileSuffix is the filename suffix of formula files.
+const formulaFileSuffix = ".formula.toml"
+
+// ListEntry summarizes a formula for listings such as the dashboard, so
+// callers can show its size and inputs without loading each formula.
+type ListEntry struct {
+	Name        string      `json:"name"`
+	Description string      `json:"description,omitempty"`
+	Type        FormulaType `json:"type,omitempty"`
+	StepCount   int         `json:"step_count"`
+	Variables   []Variable  `json:"variables,omitempty"`
+}
+
+// Variable describes one variable or input a formula accepts.
+type Variable struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Required    bool   `json:"required"`
+	Default     string `json:"default,omitempty"`
+}
+
+// StepCount returns the number of executable units in the formula: steps
+// for workflows, templates for expansions, aspects for aspect formulas, and
+// legs plus the synthesis step for convoys.
+func (f *Formula) StepCount() int {
+	switch f.Type {
+	case TypeConvoy:
+		n := len(f.Legs)
+		if f.Synthesis != nil {
+			n++
+		}
+		return n
+	case TypeExpansion:
+		return len(f.Template)
+	case TypeAspect:
+		return len(f.Aspects)
+	default:
+		return len(f.Steps)
+	}
+}
+
+// Variables returns the formula's workflow vars and convoy inputs, sorted by name.
+func (f *Formula) Variables() []Variable {
+	vars := make([]Variable, 0, len(f.Vars)+len(f.Inputs))
+	for name, v := range f.Vars {
+		vars = append(vars, Variable{Name: name, Description: v.Description, Required: v.Required, Default: v.Default})
+	}
+	for name, in := range f.Inputs {
+		vars = append(vars, Variable{Name: name, Description: in.Description, Required: in.Required, Default: in.Default})
+	}
+	sort.Slice(vars, func(i, j int) bool { return vars[i].Name < vars[j].Name })
+	return vars
+}
+
+// ListEntry summarizes the formula.
+func (f *Formula) ListEntry() ListEntry {
+	return ListEntry{
+		Name:        f.Name,
+		Description: f.Description,
+		Type:        f.Type,
+		StepCount:   f.StepCount(),
+		Variables:   f.Variables(),
+	}
+}
+
+// ListDir summarizes every formula file in dir (e.g. .beads/formulas), sorted by name.
+func ListDir(dir string) ([]ListEntry, error) {
+	return listFS(os.DirFS(dir), ".")
+}
+
+// ListEmbedded summarizes the formulas embedded in the gt binary, sorted by name.
+func ListEmbedded() ([]ListEntry, error) {
+	return listFS(formulasFS, "formulas")
+}
+
+// listFS summarizes the formula files in dir of fsys. Formulas are decoded
+// without validation: a listing should show every formula, including ones
+// that are not yet runnable.
+func listFS(fsys fs.FS, dir string) ([]ListEntry, error) {
+	files, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, fmt.Errorf("reading formulas directory: %w", err)
+	}
+
+	var entries []ListEntry
+	for _, file := range files {
+		if file.IsDir() || !strings.HasSuffix(file.Name(), formulaFileSuffix) {
+			continue
+		}
+		data, err := fs.ReadFile(fsys, path.Join(dir, file.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("reading %s: %w", file.Name(), err)
+		}
+		f, err := decode(data)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", file.Name(), err)
+		}
+		if f.Name == "" {
+			f.Name = strings.TrimSuffix(file.Name(), formulaFileSuffix)
+		}
+		entries = append(entries, f.ListEntry())
+	}
+
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Name < entries[j].Name })
+	return entries, nil
+}
@@ -0,0 +1,112 @@
+package formula
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestListDir(t *testing.T) {
+	dir := t.TempDir()
+	workflow := `
+formula = "ship-it"
+description = "Ship a feature"
+
+[[steps]]
+id = "build"
+title = "Build"
+
+[[steps]]
+id = "test"
+title = "Test"
+needs = ["build"]
+
+[[steps]]
+id = "release"
+title = "Release"
+needs = ["test"]
+
+[vars.version]
+description = "Version to release"
+required = true
+
+[vars.channel]
+description = "Release channel"
+default = "stable"
+`
+	convoy := `
+formula = "review"
+type = "convoy"
+
+[[legs]]
+id = "security"
+title = "Security"
+
+[[legs]]
+id = "style"
+title = "Style"
+
+[synthesis]
+title = "Combine"
+
+[inputs.pr]
+description = "Pull request number"
+required = true
+`
+	for name, content := range map[string]string{
+		"ship-it.formula.toml": workflow,
+		"review.formula.toml":  convoy,
+		"notes.txt":            "not a formula",
+	} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	entries, err := ListDir(dir)
+	if err != nil {
+		t.Fatalf("ListDir: %v", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("got %d entries, want 2: %+v", len(entries), entries)
+	}
+
+	review, ship := entries[0], entries[1]
+	if review.Name != "review" || review.StepCount != 3 {
+		t.Errorf("review = %+v, want 2 legs + synthesis", review)
+	}
+	if len(review.Variables) != 1 || review.Variables[0].Name != "pr" || !review.Variables[0].Required {
+		t.Errorf("review variables = %+v", review.Variables)
+	}
+
+	if ship.Name != "ship-it" || ship.Type != TypeWorkflow || ship.StepCount != 3 {
+		t.Errorf("ship-it = %+v, want 3-step workflow", ship)
+	}
+	want := []Variable{
+		{Name: "channel", Description: "Release channel", Default: "stable"},
+		{Name: "version", Description: "Version to release", Required: true},
+	}
+	if len(ship.Variables) != len(want) {
+		t.Fatalf("ship-it variables = %+v, want %+v", ship.Variables, want)
+	}
+	for i := range want {
+		if ship.Variables[i] != want[i] {
+			t.Errorf("variable %d = %+v, want %+v", i, ship.Variables[i], want[i])
+		}
+	}
+}
+
+func TestListEmbedded(t *testing.T) {
+	entries, err := ListEmbedded()
+	if err != nil {
+		t.Fatalf("ListEmbedded: %v", err)
+	}
+	if len(entries) == 0 {
+		t.Fatal("no embedded formulas listed")
+	}
+	for _, e := range entries {
+		if e.Name == "" {
+			t.Errorf("entry without name: %+v", e)
+		}
+	}
+}
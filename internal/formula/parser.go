@@ -5,6 +5,7 @@ import (
 	"os"
 
 	"github.com/BurntSushi/toml"
+	"github.com/steveyegge/gastown/internal/beads"
 )
 
 // ParseFile reads and parses a formula.toml file.
@@ -18,6 +19,20 @@ func ParseFile(path string) (*Formula, error) {
 
 // Parse parses formula.toml content from bytes.
 func Parse(data []byte) (*Formula, error) {
+	f, err := decode(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := f.Validate(); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// decode parses formula TOML and infers its type without validating it.
+func decode(data []byte) (*Formula, error) {
 	var f Formula
 	if _, err := toml.Decode(string(data), &f); err != nil {
 		return nil, fmt.Errorf("parsing TOML: %w", err)
@@ -26,10 +41,6 @@ func Parse(data []byte) (*Formula, error) {
 	// Infer type from content if not explicitly set
 	f.inferType()
 
-	if err := f.Validate(); err != nil {
-		return nil, err
-	}
-
 	return &f, nil
 }
 
@@ -130,6 +141,11 @@ func (f *Formula) validateWorkflow() error {
 				return fmt.Errorf("step %q needs unknown step: %s", step.ID, need)
 			}
 		}
+		if step.Condition != "" {
+			if _, err := beads.ParseCondition(step.Condition); err != nil {
+				return fmt.Errorf("step %q condition: %w", step.ID, err)
+			}
+		}
 	}
 
 	// Check for cycles
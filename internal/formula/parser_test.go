@@ -1,6 +1,7 @@
 package formula
 
 import (
+	"strings"
 	"testing"
 )
 
@@ -201,6 +202,35 @@ needs = ["nonexistent"]
 	}
 }
 
+func TestValidate_StepCondition(t *testing.T) {
+	data := []byte(`
+formula = "test"
+type = "workflow"
+version = 1
+[[steps]]
+id = "test"
+title = "Test"
+[[steps]]
+id = "fix"
+title = "Fix"
+needs = ["test"]
+condition = "test.label == failed"
+`)
+
+	f, err := Parse(data)
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if got := f.GetStep("fix").Condition; got != "test.label == failed" {
+		t.Errorf("Condition = %q, want %q", got, "test.label == failed")
+	}
+
+	bad := []byte(strings.Replace(string(data), "test.label == failed", "test.title ~ failed", 1))
+	if _, err := Parse(bad); err == nil {
+		t.Error("expected error for invalid condition")
+	}
+}
+
 func TestValidate_Cycle(t *testing.T) {
 	data := []byte(`
 formula = "test"
@@ -93,6 +93,11 @@ type Step struct {
 	Title       string   `toml:"title"`
 	Description string   `toml:"description"`
 	Needs       []string `toml:"needs"`
+
+	// Condition makes the step a branch: it runs only when the expression
+	// holds once its needs are done, and is skipped otherwise. See
+	// beads.ParseCondition for the syntax, e.g. "test.label == failed".
+	Condition string `toml:"condition"`
 }
 
 // Template represents a template step in an expansion formula.
@@ -417,15 +417,15 @@ func (m *Manager) AddRig(opts AddRigOptions) (*Rig, error) {
 		// beads.db is gitignored so it won't exist after clone - we need to create it.
 		// bd init --prefix will create the database and auto-import from issues.jsonl.
 		if _, err := os.Stat(sourceBeadsDB); os.IsNotExist(err) {
-			cmd := exec.Command("bd", "init", "--prefix", opts.BeadsPrefix) // opts.BeadsPrefix validated earlier
-			cmd.Dir = mayorRigPath
-			if output, err := cmd.CombinedOutput(); err != nil {
-				fmt.Printf("  Warning: Could not init bd database: %v (%s)\n", err, strings.TrimSpace(string(output)))
+			// Configure custom types for Gas Town (beads v0.46.0+) as part of init.
+			err := beads.NewWithBeadsDir(mayorRigPath, sourceBeadsDir).InitWithOptions(beads.InitOptions{
+				Prefix: opts.BeadsPrefix, // opts.BeadsPrefix validated earlier
+				Config: map[string]string{"types.custom": constants.BeadsCustomTypes},
+			})
+			var cfgErr *beads.ConfigError
+			if err != nil && !errors.As(err, &cfgErr) { // Ignore config errors - older beads don't need this
+				fmt.Printf("  Warning: Could not init bd database: %v\n", err)
 			}
-			// Configure custom types for Gas Town (beads v0.46.0+)
-			configCmd := exec.Command("bd", "config", "set", "types.custom", constants.BeadsCustomTypes)
-			configCmd.Dir = mayorRigPath
-			_, _ = configCmd.CombinedOutput() // Ignore errors - older beads don't need this
 		}
 	}
 
@@ -649,12 +649,15 @@ func (m *Manager) initBeads(rigPath, prefix string) error {
 	}
 	filteredEnv = append(filteredEnv, "BEADS_DIR="+beadsDir)
 
-	// Run bd init if available
-	cmd := exec.Command("bd", "init", "--prefix", prefix)
-	cmd.Dir = rigPath
-	cmd.Env = filteredEnv
-	_, err := cmd.CombinedOutput()
-	if err != nil {
+	// Run bd init if available, configuring custom types for Gas Town
+	// (agent, role, rig, convoy) in the same step. These were extracted from
+	// beads core in v0.46.0 and now require explicit config.
+	err := beads.NewWithBeadsDir(rigPath, beadsDir).InitWithOptions(beads.InitOptions{
+		Prefix: prefix,
+		Config: map[string]string{"types.custom": constants.BeadsCustomTypes},
+	})
+	var cfgErr *beads.ConfigError
+	if err != nil && !errors.As(err, &cfgErr) {
 		// bd might not be installed or failed, create minimal structure
 		// Note: beads currently expects YAML format for config
 		configPath := filepath.Join(beadsDir, "config.yaml")
@@ -663,14 +666,7 @@ func (m *Manager) initBeads(rigPath, prefix string) error {
 			return writeErr
 		}
 	}
-
-	// Configure custom types for Gas Town (agent, role, rig, convoy).
-	// These were extracted from beads core in v0.46.0 and now require explicit config.
-	configCmd := exec.Command("bd", "config", "set", "types.custom", constants.BeadsCustomTypes)
-	configCmd.Dir = rigPath
-	configCmd.Env = filteredEnv
-	// Ignore errors - older beads versions don't need this
-	_, _ = configCmd.CombinedOutput()
+	// Config errors are ignored - older beads versions don't need custom types
 
 	// Ensure database has repository fingerprint (GH #25).
 	// This is idempotent - safe on both new and legacy (pre-0.17.5) databases.
@@ -0,0 +1,119 @@
+package swarm
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/steveyegge/gastown/internal/beads"
+)
+
+// ProblemKind classifies a structural problem found in a swarm epic.
+type ProblemKind string
+
+const (
+	// ProblemNoChildren means the epic has no child beads to swarm.
+	ProblemNoChildren ProblemKind = "no_children"
+
+	// ProblemNoAcceptance means a child has no acceptance criteria, so a
+	// worker cannot tell when the task is done.
+	ProblemNoAcceptance ProblemKind = "no_acceptance_criteria"
+
+	// ProblemUnexpectedType means a child is not a workable leaf type
+	// (e.g. a nested epic), so no polecat can take it directly.
+	ProblemUnexpectedType ProblemKind = "unexpected_type"
+
+	// ProblemExternalDependency means a child is blocked by an open bead
+	// outside the swarm, which the swarm itself will never close.
+	ProblemExternalDependency ProblemKind = "external_dependency"
+)
+
+// swarmTaskTypes are the child bead types a polecat can work directly.
+var swarmTaskTypes = map[string]bool{
+	"task":    true,
+	"bug":     true,
+	"feature": true,
+	"chore":   true,
+}
+
+// ValidationProblem is one structural problem in a swarm epic.
+type ValidationProblem struct {
+	Kind    ProblemKind `json:"kind"`
+	IssueID string      `json:"issue_id"`
+	Detail  string      `json:"detail"`
+}
+
+// ValidationReport lists the structural problems found in a swarm epic.
+type ValidationReport struct {
+	EpicID   string              `json:"epic_id"`
+	Children int                 `json:"children"`
+	Problems []ValidationProblem `json:"problems,omitempty"`
+}
+
+// Valid returns true if no problems were found.
+func (r *ValidationReport) Valid() bool {
+	return len(r.Problems) == 0
+}
+
+func (r *ValidationReport) add(kind ProblemKind, issueID, format string, args ...interface{}) {
+	r.Problems = append(r.Problems, ValidationProblem{
+		Kind:    kind,
+		IssueID: issueID,
+		Detail:  fmt.Sprintf(format, args...),
+	})
+}
+
+// Validate inspects the children of epicID for problems that would stall a
+// swarm: children with no acceptance criteria, children that are not
+// workable task types, and dependencies on open beads outside the swarm.
+// The error is non-nil only if beads could not be queried.
+func (m *Manager) Validate(epicID string) (*ValidationReport, error) {
+	b := beads.New(m.beadsDir)
+
+	children, err := b.List(beads.ListOptions{Parent: epicID, Status: "all", Priority: -1})
+	if err != nil {
+		return nil, fmt.Errorf("listing children of %s: %w", epicID, err)
+	}
+
+	report := &ValidationReport{EpicID: epicID, Children: len(children)}
+	if len(children) == 0 {
+		report.add(ProblemNoChildren, epicID, "epic has no child beads")
+		return report, nil
+	}
+
+	ids := make([]string, len(children))
+	inSwarm := make(map[string]bool, len(children))
+	for i, child := range children {
+		ids[i] = child.ID
+		inSwarm[child.ID] = true
+	}
+	sort.Strings(ids)
+
+	// List output omits acceptance criteria and dependency details.
+	details, _, err := b.ShowOrdered(ids)
+	if err != nil {
+		return nil, fmt.Errorf("loading children of %s: %w", epicID, err)
+	}
+
+	for _, child := range details {
+		id := child.ID
+		if child.Status == "closed" {
+			continue
+		}
+
+		if !swarmTaskTypes[child.Type] {
+			report.add(ProblemUnexpectedType, id, "type %q is not a swarmable task type", child.Type)
+		}
+		if strings.TrimSpace(child.Acceptance) == "" {
+			report.add(ProblemNoAcceptance, id, "no acceptance criteria")
+		}
+		for _, dep := range child.Dependencies {
+			if dep.DependencyType != "blocks" || inSwarm[dep.ID] || dep.Status == "closed" {
+				continue
+			}
+			report.add(ProblemExternalDependency, id, "blocked by %s (%s), which is outside the swarm", dep.ID, dep.Status)
+		}
+	}
+
+	return report, nil
+}
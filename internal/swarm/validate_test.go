@@ -0,0 +1,110 @@
+package swarm
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/steveyegge/gastown/internal/rig"
+)
+
+// installValidateStub puts a fake bd on PATH that lists listJSON as the
+// epic's children and returns showJSON for bd show.
+func installValidateStub(t *testing.T, listJSON, showJSON string) {
+	t.Helper()
+	binDir := t.TempDir()
+	script := `#!/bin/sh
+while [ "$1" = "--allow-stale" ] || [ "$1" = "--no-daemon" ]; do shift; done
+case "$1" in
+  list) printf '%s\n' '` + listJSON + `' ;;
+  show) printf '%s\n' '` + showJSON + `' ;;
+esac
+exit 0
+`
+	if err := os.WriteFile(filepath.Join(binDir, "bd"), []byte(script), 0755); err != nil {
+		t.Fatalf("write bd stub: %v", err)
+	}
+	t.Setenv("PATH", binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+}
+
+func newValidateManager(t *testing.T) *Manager {
+	t.Helper()
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, ".beads"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	return NewManager(&rig.Rig{Name: "test-rig", Path: dir})
+}
+
+func TestValidateReportsProblems(t *testing.T) {
+	installValidateStub(t,
+		`[{"id":"gt-a"},{"id":"gt-b"},{"id":"gt-c"},{"id":"gt-d"}]`,
+		`[
+{"id":"gt-a","status":"open","issue_type":"task","acceptance_criteria":"tests pass",
+ "dependencies":[{"id":"gt-epic","status":"open","dependency_type":"parent-child"}]},
+{"id":"gt-b","status":"open","issue_type":"task",
+ "dependencies":[{"id":"gt-a","status":"open","dependency_type":"blocks"},
+                 {"id":"gt-other","status":"open","dependency_type":"blocks"}]},
+{"id":"gt-c","status":"open","issue_type":"epic","acceptance_criteria":"all children done"},
+{"id":"gt-d","status":"closed","issue_type":"epic"}
+]`)
+
+	report, err := newValidateManager(t).Validate("gt-epic")
+	if err != nil {
+		t.Fatalf("Validate: %v", err)
+	}
+	if report.Children != 4 {
+		t.Errorf("Children = %d, want 4", report.Children)
+	}
+
+	want := []struct {
+		kind ProblemKind
+		id   string
+	}{
+		{ProblemNoAcceptance, "gt-b"},
+		{ProblemExternalDependency, "gt-b"},
+		{ProblemUnexpectedType, "gt-c"},
+	}
+	if len(report.Problems) != len(want) {
+		t.Fatalf("Problems = %+v, want %d", report.Problems, len(want))
+	}
+	for i, w := range want {
+		if p := report.Problems[i]; p.Kind != w.kind || p.IssueID != w.id {
+			t.Errorf("problem %d = %+v, want %s on %s", i, p, w.kind, w.id)
+		}
+	}
+	if report.Valid() {
+		t.Error("Valid() = true with problems")
+	}
+}
+
+func TestValidateNoChildren(t *testing.T) {
+	installValidateStub(t, `[]`, `[]`)
+
+	report, err := newValidateManager(t).Validate("gt-epic")
+	if err != nil {
+		t.Fatalf("Validate: %v", err)
+	}
+	if len(report.Problems) != 1 || report.Problems[0].Kind != ProblemNoChildren {
+		t.Errorf("Problems = %+v, want no_children", report.Problems)
+	}
+}
+
+func TestValidateClean(t *testing.T) {
+	installValidateStub(t,
+		`[{"id":"gt-a"},{"id":"gt-b"}]`,
+		`[
+{"id":"gt-a","status":"open","issue_type":"task","acceptance_criteria":"done"},
+{"id":"gt-b","status":"open","issue_type":"bug","acceptance_criteria":"fixed",
+ "dependencies":[{"id":"gt-a","status":"open","dependency_type":"blocks"},
+                 {"id":"gt-old","status":"closed","dependency_type":"blocks"}]}
+]`)
+
+	report, err := newValidateManager(t).Validate("gt-epic")
+	if err != nil {
+		t.Fatalf("Validate: %v", err)
+	}
+	if !report.Valid() {
+		t.Errorf("Problems = %+v, want none", report.Problems)
+	}
+}